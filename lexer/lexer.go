@@ -48,6 +48,120 @@ const (
 
 	// KEYS signifies several key attributes follow.
 	KEYS
+
+	// INSERT inserts a key-value pair only if the key does not exist.
+	INSERT
+
+	// UPDATE replaces a key-value pair only if the key already exists.
+	UPDATE
+
+	// IF prefixes the condition of a compare-and-set write.
+	IF
+
+	// VALUE refers to the current value of a key in a condition.
+	VALUE
+
+	// VERSION refers to the current version of a key in a condition.
+	VERSION
+
+	// PATCH merges a partial value into an existing key-value pair.
+	PATCH
+
+	// SET assigns values to paths within a value.
+	SET
+
+	// INCREMENT adds to a counter value.
+	INCREMENT
+
+	// DECREMENT subtracts from a counter value.
+	DECREMENT
+
+	// BY sets the amount a counter is changed by.
+	BY
+
+	// BEGIN starts a transaction.
+	BEGIN
+
+	// COMMIT commits the current transaction.
+	COMMIT
+
+	// ROLLBACK aborts the current transaction.
+	ROLLBACK
+
+	// BATCH starts a list of writes that are applied atomically.
+	BATCH
+
+	// APPLY ends a BATCH.
+	APPLY
+
+	// AS starts an AS OF clause.
+	AS
+
+	// OF sets the point in time an AS OF clause reads from.
+	OF
+
+	// COMPACTION sets the compaction strategy of a keyspace.
+	COMPACTION
+
+	// COMPRESSION sets the block compression codec of a keyspace.
+	COMPRESSION
+
+	// BACKUP writes a consistent snapshot of the database.
+	BACKUP
+
+	// RESTORE loads the database from a snapshot.
+	RESTORE
+
+	// TO sets the destination of a statement.
+	TO
+
+	// COPY bulk loads or dumps a keyspace.
+	COPY
+
+	// FORMAT sets the file format of a COPY.
+	FORMAT
+
+	// WATCH subscribes to changes of matching keys.
+	WATCH
+
+	// INDEX signifies a secondary index is being created or dropped.
+	INDEX
+
+	// ON sets which keyspace an index belongs to.
+	ON
+
+	// VIEW signifies a materialized view is being created.
+	VIEW
+
+	// ALTER starts an ALTER KEYSPACE query.
+	ALTER
+
+	// ADD adds a key attribute to a keyspace.
+	ADD
+
+	// DEFAULT sets the value of a new key attribute for existing keys.
+	DEFAULT
+
+	// RENAME renames a key attribute.
+	RENAME
+
+	// SHOW lists database objects or statistics.
+	SHOW
+
+	// DESCRIBE shows the definition of a keyspace.
+	DESCRIBE
+
+	// KEYSPACES signifies keyspaces are being listed.
+	KEYSPACES
+
+	// LIKE filters a listing by a pattern.
+	LIKE
+
+	// INDEXES signifies indexes are being listed.
+	INDEXES
+
+	// STATS signifies database statistics are being listed.
+	STATS
 	endKeywords
 
 	// Separates the keywords from the conditionals
@@ -72,7 +186,48 @@ var tokenMap = map[lexer.Token]string{
 	WITH:     "WITH",
 	KEY:      "KEY",
 	KEYS:     "KEYS",
-	BETWEEN:  "BETWEEN",
+	INSERT:   "INSERT",
+	UPDATE:   "UPDATE",
+	IF:       "IF",
+	VALUE:    "VALUE",
+	VERSION:  "VERSION",
+	PATCH:    "PATCH",
+	SET:      "SET",
+
+	INCREMENT: "INCREMENT",
+	DECREMENT: "DECREMENT",
+	BY:        "BY",
+	BEGIN:     "BEGIN",
+	COMMIT:    "COMMIT",
+	ROLLBACK:  "ROLLBACK",
+	BATCH:     "BATCH",
+	APPLY:     "APPLY",
+	AS:        "AS",
+	OF:        "OF",
+
+	COMPACTION:  "COMPACTION",
+	COMPRESSION: "COMPRESSION",
+	BACKUP:      "BACKUP",
+	RESTORE:     "RESTORE",
+	TO:          "TO",
+	COPY:        "COPY",
+	FORMAT:      "FORMAT",
+	WATCH:       "WATCH",
+	INDEX:       "INDEX",
+	ON:          "ON",
+	VIEW:        "VIEW",
+	ALTER:       "ALTER",
+	ADD:         "ADD",
+	DEFAULT:     "DEFAULT",
+	RENAME:      "RENAME",
+	SHOW:        "SHOW",
+	DESCRIBE:    "DESCRIBE",
+	KEYSPACES:   "KEYSPACES",
+	LIKE:        "LIKE",
+	INDEXES:     "INDEXES",
+	STATS:       "STATS",
+
+	BETWEEN: "BETWEEN",
 }
 
 // IsKeyword returns true if the token is a keyword.
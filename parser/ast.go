@@ -2,6 +2,7 @@ package parser
 
 import (
 	"bytes"
+	"strconv"
 	"strings"
 )
 
@@ -18,6 +19,36 @@ const (
 	ExpressionType
 	KeyAttributeType
 	BetweenType
+	InsertType
+	UpdateType
+	PatchType
+	IncrementType
+	BeginType
+	CommitType
+	RollbackType
+	BatchType
+	BackupType
+	RestoreType
+	CopyFromType
+	CopyToType
+	WatchType
+	CreateIndexType
+	DropIndexType
+	CreateViewType
+	AlterAddKeyType
+	AlterRenameKeyType
+	AlterOptionsType
+	AlterKeysType
+	RenameKeyspaceType
+	CopyKeyspaceType
+	ShowKeyspacesType
+	DescribeKeyspaceType
+	ShowIndexesType
+	ShowStatsType
+	NumberLiteralType
+	ValueConditionType
+	VersionConditionType
+	AssignmentType
 )
 
 type Node interface {
@@ -28,6 +59,7 @@ type Node interface {
 type CreateStatement struct {
 	Keyspace string
 	Keys     []string
+	Options  KeyspaceOptions
 }
 
 func (CreateStatement) NodeType() NodeType {
@@ -46,7 +78,138 @@ func (c CreateStatement) String() string {
 		buf.WriteString("Key ")
 	}
 
-	buf.WriteString(strings.Join(c.Keys, ", ") + ";")
+	buf.WriteString(strings.Join(c.Keys, ", "))
+	if opts := c.Options.String(); opts != "" {
+		buf.WriteString(" AND ")
+		buf.WriteString(opts)
+	}
+	buf.WriteString(";")
+	return buf.String()
+}
+
+// Compaction strategies for the COMPACTION keyspace option.
+const (
+	LeveledCompaction    = "leveled"
+	SizeTieredCompaction = "size_tiered"
+	TimeWindowCompaction = "time_window"
+)
+
+// Compression codecs for the COMPRESSION keyspace option.
+const (
+	NoCompression     = "none"
+	SnappyCompression = "snappy"
+	ZstdCompression   = "zstd"
+)
+
+// KeyspaceOptions holds the options of a keyspace. Empty options use
+// the database defaults.
+type KeyspaceOptions struct {
+	Compaction  string
+	Compression string
+}
+
+// String returns a string representation
+func (o KeyspaceOptions) String() string {
+	var opts []string
+	if o.Compaction != "" {
+		opts = append(opts, "COMPACTION = "+o.Compaction)
+	}
+	if o.Compression != "" {
+		opts = append(opts, "COMPRESSION = "+o.Compression)
+	}
+	return strings.Join(opts, " AND ")
+}
+
+// AlterAddKeyStatement appends a key attribute to a keyspace. Existing
+// keys are migrated using the Default value for the new attribute.
+type AlterAddKeyStatement struct {
+	Keyspace string
+	Key      string
+	Default  string
+}
+
+func (AlterAddKeyStatement) NodeType() NodeType {
+	return AlterAddKeyType
+}
+
+// String returns a string representation
+func (a AlterAddKeyStatement) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("ALTER KEYSPACE ")
+	buf.WriteString(a.Keyspace)
+	buf.WriteString(" ADD KEY ")
+	buf.WriteString(a.Key)
+	buf.WriteString(" DEFAULT ")
+	buf.WriteString(a.Default)
+	buf.WriteString(";")
+	return buf.String()
+}
+
+// AlterRenameKeyStatement renames a key attribute of a keyspace.
+type AlterRenameKeyStatement struct {
+	Keyspace string
+	Key      string
+	To       string
+}
+
+func (AlterRenameKeyStatement) NodeType() NodeType {
+	return AlterRenameKeyType
+}
+
+// String returns a string representation
+func (a AlterRenameKeyStatement) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("ALTER KEYSPACE ")
+	buf.WriteString(a.Keyspace)
+	buf.WriteString(" RENAME KEY ")
+	buf.WriteString(a.Key)
+	buf.WriteString(" TO ")
+	buf.WriteString(a.To)
+	buf.WriteString(";")
+	return buf.String()
+}
+
+// AlterOptionsStatement changes the options of a keyspace. Options left
+// empty are unchanged.
+type AlterOptionsStatement struct {
+	Keyspace string
+	Options  KeyspaceOptions
+}
+
+func (AlterOptionsStatement) NodeType() NodeType {
+	return AlterOptionsType
+}
+
+// String returns a string representation
+func (a AlterOptionsStatement) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("ALTER KEYSPACE ")
+	buf.WriteString(a.Keyspace)
+	buf.WriteString(" SET ")
+	buf.WriteString(a.Options.String())
+	buf.WriteString(";")
+	return buf.String()
+}
+
+// AlterKeysStatement reorders the key attributes of a keyspace. Keys must
+// list every existing key attribute exactly once.
+type AlterKeysStatement struct {
+	Keyspace string
+	Keys     []string
+}
+
+func (AlterKeysStatement) NodeType() NodeType {
+	return AlterKeysType
+}
+
+// String returns a string representation
+func (a AlterKeysStatement) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("ALTER KEYSPACE ")
+	buf.WriteString(a.Keyspace)
+	buf.WriteString(" SET KEYS ")
+	buf.WriteString(strings.Join(a.Keys, ", "))
+	buf.WriteString(";")
 	return buf.String()
 }
 
@@ -67,8 +230,128 @@ func (d DropStatement) String() string {
 	return buf.String()
 }
 
+// CreateIndexStatement creates a secondary index over the JSON value at
+// Path for every key in a keyspace.
+type CreateIndexStatement struct {
+	Index    string
+	Keyspace string
+	Path     string
+}
+
+func (CreateIndexStatement) NodeType() NodeType {
+	return CreateIndexType
+}
+
+// String returns a string representation
+func (c CreateIndexStatement) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("CREATE INDEX ")
+	buf.WriteString(c.Index)
+	buf.WriteString(" ON ")
+	buf.WriteString(c.Keyspace)
+	buf.WriteString(" (VALUE.")
+	buf.WriteString(c.Path)
+	buf.WriteString(");")
+	return buf.String()
+}
+
+// CreateViewStatement creates a materialized view holding the keys of a
+// keyspace re-keyed by the given key attributes.
+type CreateViewStatement struct {
+	View     string
+	Keyspace string
+	Keys     []string
+}
+
+func (CreateViewStatement) NodeType() NodeType {
+	return CreateViewType
+}
+
+// String returns a string representation
+func (c CreateViewStatement) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("CREATE VIEW ")
+	buf.WriteString(c.View)
+	buf.WriteString(" AS SELECT FROM ")
+	buf.WriteString(c.Keyspace)
+	buf.WriteString(" WITH ")
+	if len(c.Keys) > 1 {
+		buf.WriteString("KEYS ")
+	} else {
+		buf.WriteString("KEY ")
+	}
+	buf.WriteString(strings.Join(c.Keys, ", "))
+	buf.WriteString(";")
+	return buf.String()
+}
+
+type DropIndexStatement struct {
+	Index    string
+	Keyspace string
+}
+
+func (DropIndexStatement) NodeType() NodeType {
+	return DropIndexType
+}
+
+// String returns a string representation
+func (d DropIndexStatement) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("DROP INDEX ")
+	buf.WriteString(d.Index)
+	buf.WriteString(" ON ")
+	buf.WriteString(d.Keyspace)
+	buf.WriteString(";")
+	return buf.String()
+}
+
+// RenameKeyspaceStatement renames a keyspace without moving its keys.
+type RenameKeyspaceStatement struct {
+	Keyspace string
+	To       string
+}
+
+func (RenameKeyspaceStatement) NodeType() NodeType {
+	return RenameKeyspaceType
+}
+
+// String returns a string representation
+func (r RenameKeyspaceStatement) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("RENAME KEYSPACE ")
+	buf.WriteString(r.Keyspace)
+	buf.WriteString(" TO ")
+	buf.WriteString(r.To)
+	buf.WriteString(";")
+	return buf.String()
+}
+
+// CopyKeyspaceStatement copies a snapshot of a keyspace into a new keyspace.
+type CopyKeyspaceStatement struct {
+	Keyspace string
+	To       string
+}
+
+func (CopyKeyspaceStatement) NodeType() NodeType {
+	return CopyKeyspaceType
+}
+
+// String returns a string representation
+func (c CopyKeyspaceStatement) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("COPY KEYSPACE ")
+	buf.WriteString(c.Keyspace)
+	buf.WriteString(" TO ")
+	buf.WriteString(c.To)
+	buf.WriteString(";")
+	return buf.String()
+}
+
+// SelectStatement reads keys from a keyspace. AsOf is either a timestamp
+// StringLiteral or a sequence NumberLiteral and is nil for current reads.
 type SelectStatement struct {
 	Keyspace string
+	AsOf     Node
 	Where    []Expression
 }
 
@@ -81,6 +364,10 @@ func (s SelectStatement) String() string {
 	var buf bytes.Buffer
 	buf.WriteString("SELECT FROM ")
 	buf.WriteString(s.Keyspace)
+	if s.AsOf != nil {
+		buf.WriteString(" AS OF ")
+		buf.WriteString(s.AsOf.String())
+	}
 	buf.WriteString(" WHERE ")
 
 	var filters []string
@@ -93,9 +380,10 @@ func (s SelectStatement) String() string {
 }
 
 type UpsertStatement struct {
-	Value    string
-	Keyspace string
-	Where    []Expression
+	Value     string
+	Keyspace  string
+	Where     []Expression
+	Condition Node
 }
 
 func (UpsertStatement) NodeType() NodeType {
@@ -116,6 +404,145 @@ func (u UpsertStatement) String() string {
 		filters = append(filters, exp.String())
 	}
 	buf.WriteString(strings.Join(filters, " AND "))
+	if u.Condition != nil {
+		buf.WriteString(" IF ")
+		buf.WriteString(u.Condition.String())
+	}
+	buf.WriteString(";")
+	return buf.String()
+}
+
+type InsertStatement struct {
+	Value    string
+	Keyspace string
+	Where    []Expression
+}
+
+func (InsertStatement) NodeType() NodeType {
+	return InsertType
+}
+
+// String returns a string representation
+func (i InsertStatement) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("INSERT ")
+	buf.WriteString(i.Value)
+	buf.WriteString(" INTO ")
+	buf.WriteString(i.Keyspace)
+	buf.WriteString(" WHERE ")
+
+	var filters []string
+	for _, exp := range i.Where {
+		filters = append(filters, exp.String())
+	}
+	buf.WriteString(strings.Join(filters, " AND "))
+	buf.WriteString(";")
+	return buf.String()
+}
+
+type UpdateStatement struct {
+	Value    string
+	Keyspace string
+	Where    []Expression
+}
+
+func (UpdateStatement) NodeType() NodeType {
+	return UpdateType
+}
+
+// String returns a string representation
+func (u UpdateStatement) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("UPDATE ")
+	buf.WriteString(u.Value)
+	buf.WriteString(" INTO ")
+	buf.WriteString(u.Keyspace)
+	buf.WriteString(" WHERE ")
+
+	var filters []string
+	for _, exp := range u.Where {
+		filters = append(filters, exp.String())
+	}
+	buf.WriteString(strings.Join(filters, " AND "))
+	buf.WriteString(";")
+	return buf.String()
+}
+
+// PatchStatement merges a JSON merge patch (RFC 7386) or a list of
+// assignments into the value of a key.
+type PatchStatement struct {
+	Value    string
+	Set      []Assignment
+	Keyspace string
+	Where    []Expression
+}
+
+func (PatchStatement) NodeType() NodeType {
+	return PatchType
+}
+
+// String returns a string representation
+func (p PatchStatement) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("PATCH ")
+	if len(p.Set) == 0 {
+		buf.WriteString(p.Value)
+		buf.WriteString(" ")
+	}
+	buf.WriteString("INTO ")
+	buf.WriteString(p.Keyspace)
+	if len(p.Set) > 0 {
+		var assignments []string
+		for _, a := range p.Set {
+			assignments = append(assignments, a.String())
+		}
+		buf.WriteString(" SET ")
+		buf.WriteString(strings.Join(assignments, ", "))
+	}
+	buf.WriteString(" WHERE ")
+
+	var filters []string
+	for _, exp := range p.Where {
+		filters = append(filters, exp.String())
+	}
+	buf.WriteString(strings.Join(filters, " AND "))
+	buf.WriteString(";")
+	return buf.String()
+}
+
+// IncrementStatement adds Delta to a counter value. Negative deltas
+// are the result of a DECREMENT.
+type IncrementStatement struct {
+	Keyspace string
+	Delta    int64
+	Where    []Expression
+}
+
+func (IncrementStatement) NodeType() NodeType {
+	return IncrementType
+}
+
+// String returns a string representation
+func (i IncrementStatement) String() string {
+	var buf bytes.Buffer
+	if i.Delta < 0 {
+		buf.WriteString("DECREMENT ")
+		buf.WriteString(i.Keyspace)
+		buf.WriteString(" BY ")
+		buf.WriteString(strconv.FormatUint(uint64(-i.Delta), 10))
+	} else {
+		buf.WriteString("INCREMENT ")
+		buf.WriteString(i.Keyspace)
+		buf.WriteString(" BY ")
+		buf.WriteString(strconv.FormatInt(i.Delta, 10))
+	}
+	buf.WriteString(" WHERE ")
+
+	var filters []string
+	for _, exp := range i.Where {
+		filters = append(filters, exp.String())
+	}
+	buf.WriteString(strings.Join(filters, " AND "))
 	buf.WriteString(";")
 	return buf.String()
 }
@@ -145,6 +572,229 @@ func (d DeleteStatement) String() string {
 	return buf.String()
 }
 
+type BeginStatement struct{}
+
+func (BeginStatement) NodeType() NodeType {
+	return BeginType
+}
+
+// String returns a string representation
+func (BeginStatement) String() string {
+	return "BEGIN;"
+}
+
+type CommitStatement struct{}
+
+func (CommitStatement) NodeType() NodeType {
+	return CommitType
+}
+
+// String returns a string representation
+func (CommitStatement) String() string {
+	return "COMMIT;"
+}
+
+type RollbackStatement struct{}
+
+func (RollbackStatement) NodeType() NodeType {
+	return RollbackType
+}
+
+// String returns a string representation
+func (RollbackStatement) String() string {
+	return "ROLLBACK;"
+}
+
+// BatchStatement applies a list of UPSERT and DELETE statements atomically.
+type BatchStatement struct {
+	Statements []Node
+}
+
+func (BatchStatement) NodeType() NodeType {
+	return BatchType
+}
+
+// String returns a string representation
+func (b BatchStatement) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("BATCH ")
+	for _, stmt := range b.Statements {
+		buf.WriteString(stmt.String())
+		buf.WriteString(" ")
+	}
+	buf.WriteString("APPLY;")
+	return buf.String()
+}
+
+// BackupStatement writes a checkpoint of the database to a directory.
+type BackupStatement struct {
+	Path string
+}
+
+func (BackupStatement) NodeType() NodeType {
+	return BackupType
+}
+
+// String returns a string representation
+func (b BackupStatement) String() string {
+	return "BACKUP TO " + b.Path + ";"
+}
+
+// RestoreStatement restores the database from a checkpoint directory.
+type RestoreStatement struct {
+	Path string
+}
+
+func (RestoreStatement) NodeType() NodeType {
+	return RestoreType
+}
+
+// String returns a string representation
+func (r RestoreStatement) String() string {
+	return "RESTORE FROM " + r.Path + ";"
+}
+
+// File formats for COPY statements.
+const (
+	JSONLinesFormat = "jsonl"
+	CSVFormat       = "csv"
+)
+
+// CopyFromStatement bulk loads a file into a keyspace.
+type CopyFromStatement struct {
+	Keyspace string
+	Path     string
+	Format   string
+}
+
+func (CopyFromStatement) NodeType() NodeType {
+	return CopyFromType
+}
+
+// String returns a string representation
+func (c CopyFromStatement) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("COPY ")
+	buf.WriteString(c.Keyspace)
+	buf.WriteString(" FROM ")
+	buf.WriteString(c.Path)
+	buf.WriteString(" FORMAT ")
+	buf.WriteString(c.Format)
+	buf.WriteString(";")
+	return buf.String()
+}
+
+// CopyToStatement dumps a keyspace to a file.
+type CopyToStatement struct {
+	Keyspace string
+	Path     string
+	Format   string
+}
+
+func (CopyToStatement) NodeType() NodeType {
+	return CopyToType
+}
+
+// String returns a string representation
+func (c CopyToStatement) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("COPY ")
+	buf.WriteString(c.Keyspace)
+	buf.WriteString(" TO ")
+	buf.WriteString(c.Path)
+	buf.WriteString(" FORMAT ")
+	buf.WriteString(c.Format)
+	buf.WriteString(";")
+	return buf.String()
+}
+
+// WatchStatement subscribes to writes of keys matching the WHERE clause.
+type WatchStatement struct {
+	Keyspace string
+	Where    []Expression
+}
+
+func (WatchStatement) NodeType() NodeType {
+	return WatchType
+}
+
+// String returns a string representation
+func (w WatchStatement) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("WATCH FROM ")
+	buf.WriteString(w.Keyspace)
+	buf.WriteString(" WHERE ")
+
+	var filters []string
+	for _, exp := range w.Where {
+		filters = append(filters, exp.String())
+	}
+	buf.WriteString(strings.Join(filters, " AND "))
+	buf.WriteString(";")
+	return buf.String()
+}
+
+// ShowKeyspacesStatement lists keyspaces. If Like is set, only keyspaces
+// matching the pattern are listed.
+type ShowKeyspacesStatement struct {
+	Like string
+}
+
+func (ShowKeyspacesStatement) NodeType() NodeType {
+	return ShowKeyspacesType
+}
+
+// String returns a string representation
+func (s ShowKeyspacesStatement) String() string {
+	if s.Like != "" {
+		return "SHOW KEYSPACES LIKE " + s.Like + ";"
+	}
+	return "SHOW KEYSPACES;"
+}
+
+// DescribeKeyspaceStatement shows the keys, options and size of a keyspace.
+type DescribeKeyspaceStatement struct {
+	Keyspace string
+}
+
+func (DescribeKeyspaceStatement) NodeType() NodeType {
+	return DescribeKeyspaceType
+}
+
+// String returns a string representation
+func (d DescribeKeyspaceStatement) String() string {
+	return "DESCRIBE KEYSPACE " + d.Keyspace + ";"
+}
+
+// ShowIndexesStatement lists indexes. If Keyspace is set, only the indexes
+// of that keyspace are listed.
+type ShowIndexesStatement struct {
+	Keyspace string
+}
+
+func (ShowIndexesStatement) NodeType() NodeType {
+	return ShowIndexesType
+}
+
+// String returns a string representation
+func (s ShowIndexesStatement) String() string {
+	if s.Keyspace != "" {
+		return "SHOW INDEXES ON " + s.Keyspace + ";"
+	}
+	return "SHOW INDEXES;"
+}
+
+type ShowStatsStatement struct{}
+
+func (ShowStatsStatement) NodeType() NodeType {
+	return ShowStatsType
+}
+
+// String returns a string representation
+func (ShowStatsStatement) String() string {
+	return "SHOW STATS;"
+}
+
 type StringLiteral struct {
 	Value string
 }
@@ -170,6 +820,18 @@ func (s StringLiteralGroup) String() string {
 	return strings.Join(s.Values, s.Operator.String())
 }
 
+type NumberLiteral struct {
+	Value uint64
+}
+
+func (n NumberLiteral) NodeType() NodeType {
+	return NumberLiteralType
+}
+
+func (n NumberLiteral) String() string {
+	return strconv.FormatUint(n.Value, 10)
+}
+
 type KeyAttribute struct {
 	Attribute string
 }
@@ -181,3 +843,43 @@ func (s KeyAttribute) NodeType() NodeType {
 func (s KeyAttribute) String() string {
 	return s.Attribute
 }
+
+// ValueCondition only allows a write if the current value matches.
+type ValueCondition struct {
+	Value string
+}
+
+func (c ValueCondition) NodeType() NodeType {
+	return ValueConditionType
+}
+
+func (c ValueCondition) String() string {
+	return "VALUE = " + c.Value
+}
+
+// VersionCondition only allows a write if the current version matches.
+type VersionCondition struct {
+	Version uint64
+}
+
+func (c VersionCondition) NodeType() NodeType {
+	return VersionConditionType
+}
+
+func (c VersionCondition) String() string {
+	return "VERSION = " + strconv.FormatUint(c.Version, 10)
+}
+
+// Assignment sets the JSON value at a period delimited path.
+type Assignment struct {
+	Path  string
+	Value string
+}
+
+func (a Assignment) NodeType() NodeType {
+	return AssignmentType
+}
+
+func (a Assignment) String() string {
+	return a.Path + " = " + a.Value
+}
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -27,3 +28,23 @@ func (e *ParseError) Error() string {
 	}
 	return fmt.Sprintf("found %s, expected %s at line %d, char %d", e.Found, strings.Join(e.Expected, ", "), e.Pos.Line+1, e.Pos.Char+1)
 }
+
+// MarshalJSON returns the error as a JSON object. Line and char are one
+// based, matching the output of Error.
+func (e *ParseError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Error    string   `json:"error"`
+		Message  string   `json:"message,omitempty"`
+		Found    string   `json:"found,omitempty"`
+		Expected []string `json:"expected,omitempty"`
+		Line     int      `json:"line"`
+		Char     int      `json:"char"`
+	}{
+		Error:    e.Error(),
+		Message:  e.Message,
+		Found:    e.Found,
+		Expected: e.Expected,
+		Line:     e.Pos.Line + 1,
+		Char:     e.Pos.Char + 1,
+	})
+}
@@ -1,13 +1,31 @@
 package parser
 
 import (
+	"encoding/json"
 	"io"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/eliquious/lexer"
 	tokens "github.com/eliquious/prefixdb/lexer"
 )
 
+// compactionStrategies are the allowed values of the COMPACTION option.
+var compactionStrategies = []string{LeveledCompaction, SizeTieredCompaction, TimeWindowCompaction}
+
+// compressionCodecs are the allowed values of the COMPRESSION option.
+var compressionCodecs = []string{NoCompression, SnappyCompression, ZstdCompression}
+
+// softKeywords are the keywords which can also be used as identifiers.
+var softKeywords = []lexer.Token{
+	tokens.VALUE, tokens.VERSION, tokens.KEY, tokens.INDEX, tokens.INDEXES, tokens.FORMAT,
+	tokens.DEFAULT, tokens.STATS, tokens.LIKE, tokens.KEYSPACES,
+}
+
+// copyFormats are the allowed file formats of the COPY statement.
+var copyFormats = []string{JSONLinesFormat, CSVFormat}
+
 // Parser represents an PrefixDB parser.
 type Parser struct {
 	s *lexer.TokenBuffer
@@ -18,242 +36,1195 @@ func NewParser(r io.Reader) *Parser {
 	return &Parser{s: lexer.NewTokenBuffer(r)}
 }
 
-// ParseString parses a statement string and returns its AST representation.
-func ParseString(s string) (Node, error) {
-	return NewParser(strings.NewReader(s)).ParseStatement()
+// ParseString parses a statement string and returns its AST representation.
+func ParseString(s string) (Node, error) {
+	return NewParser(strings.NewReader(s)).ParseStatement()
+}
+
+// ParseStatement parses a string and returns a Statement AST object.
+func (p *Parser) ParseStatement() (Node, error) {
+
+	// Inspect the first token.
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	switch tok {
+	case tokens.CREATE:
+		return p.parseCreateStatement()
+	case tokens.DROP:
+		return p.parseDropStatement()
+	case tokens.SELECT:
+		return p.parseSelectStatement()
+	case tokens.DELETE:
+		return p.parseDeleteStatement()
+	case tokens.UPSERT:
+		return p.parseUpsertStatement()
+	case tokens.INSERT:
+		return p.parseInsertStatement()
+	case tokens.UPDATE:
+		return p.parseUpdateStatement()
+	case tokens.PATCH:
+		return p.parsePatchStatement()
+	case tokens.INCREMENT:
+		return p.parseIncrementStatement(false)
+	case tokens.DECREMENT:
+		return p.parseIncrementStatement(true)
+	case tokens.BEGIN:
+		return p.parseBeginStatement()
+	case tokens.COMMIT:
+		return p.parseCommitStatement()
+	case tokens.ROLLBACK:
+		return p.parseRollbackStatement()
+	case tokens.BATCH:
+		return p.parseBatchStatement()
+	case tokens.BACKUP:
+		return p.parseBackupStatement()
+	case tokens.RESTORE:
+		return p.parseRestoreStatement()
+	case tokens.COPY:
+		return p.parseCopyStatement()
+	case tokens.WATCH:
+		return p.parseWatchStatement()
+	case tokens.ALTER:
+		return p.parseAlterStatement()
+	case tokens.RENAME:
+		return p.parseRenameStatement()
+	case tokens.SHOW:
+		return p.parseShowStatement()
+	case tokens.DESCRIBE:
+		return p.parseDescribeStatement()
+	default:
+		return nil, NewParseError(tokstr(tok, lit), []string{"CREATE", "DROP", "SELECT", "DELETE", "UPSERT", "INSERT", "UPDATE", "PATCH", "INCREMENT", "DECREMENT", "BEGIN", "COMMIT", "ROLLBACK", "BATCH", "BACKUP", "RESTORE", "COPY", "WATCH", "ALTER", "RENAME", "SHOW", "DESCRIBE"}, pos)
+	}
+}
+
+// parseCreateStatement parses a string and returns an AST object.
+// This function assumes the "CREATE" token has already been consumed.
+func (p *Parser) parseCreateStatement() (Node, error) {
+
+	// Inspect the first token.
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	switch tok {
+	case tokens.KEYSPACE:
+		return p.parseCreateKeyspaceStatement()
+	case tokens.INDEX:
+		return p.parseCreateIndexStatement()
+	case tokens.VIEW:
+		return p.parseCreateViewStatement()
+	default:
+		return nil, NewParseError(tokstr(tok, lit), []string{"KEYSPACE", "INDEX", "VIEW"}, pos)
+	}
+}
+
+// parseCreateKeyspaceStatement parses a string and returns a CreateKeyspaceStatement.
+// This function assumes the "CREATE" token has already been consumed.
+func (p *Parser) parseCreateKeyspaceStatement() (*CreateStatement, error) {
+	stmt := &CreateStatement{}
+
+	// Parse the name of the keyspace to be used
+	lit, err := p.parseKeyspace()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Keyspace = lit
+
+	// Inspect the WITH token.
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	switch tok {
+	case tokens.WITH:
+
+		// Inspect the KEY or KEYS token.
+		tok, pos, lit := p.scanIgnoreWhitespace()
+		switch tok {
+		case tokens.KEY:
+			k, err := p.parseIdent()
+			if err != nil {
+				return nil, err
+			} else {
+				stmt.Keys = append(stmt.Keys, k)
+			}
+		case tokens.KEYS:
+			k, err := p.parseIdentList()
+			if err != nil {
+				return nil, err
+			} else {
+				stmt.Keys = k
+			}
+		default:
+			return nil, NewParseError(tokstr(tok, lit), []string{"KEY", "KEYS"}, pos)
+		}
+	default:
+		return nil, NewParseError(tokstr(tok, lit), []string{"WITH"}, pos)
+	}
+
+	// Parse the optional keyspace options
+	tok, pos, lit = p.scanIgnoreWhitespace()
+	for tok == lexer.AND {
+		if err := p.parseKeyspaceOption(&stmt.Options); err != nil {
+			return nil, err
+		}
+		tok, pos, lit = p.scanIgnoreWhitespace()
+	}
+
+	// Verify end of query
+	switch tok {
+	case lexer.EOF:
+	case lexer.SEMICOLON:
+	default:
+		return nil, NewParseError(tokstr(tok, lit), []string{"AND", "EOF", "SEMICOLON"}, pos)
+	}
+
+	return stmt, nil
+}
+
+// parseKeyspaceOption parses a single keyspace option into opts.
+func (p *Parser) parseKeyspaceOption(opts *KeyspaceOptions) error {
+
+	// Inspect the option name.
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	switch tok {
+	case tokens.COMPACTION:
+		if opts.Compaction != "" {
+			return &ParseError{Message: "duplicate COMPACTION option", Pos: pos}
+		}
+
+		value, err := p.parseOptionValue(compactionStrategies)
+		if err != nil {
+			return err
+		}
+		opts.Compaction = value
+	case tokens.COMPRESSION:
+		if opts.Compression != "" {
+			return &ParseError{Message: "duplicate COMPRESSION option", Pos: pos}
+		}
+
+		value, err := p.parseOptionValue(compressionCodecs)
+		if err != nil {
+			return err
+		}
+		opts.Compression = value
+	default:
+		return NewParseError(tokstr(tok, lit), []string{"COMPACTION", "COMPRESSION"}, pos)
+	}
+	return nil
+}
+
+// parseOptionValue parses the "= value" part of an option. The value must
+// be one of the allowed values.
+func (p *Parser) parseOptionValue(allowed []string) (string, error) {
+
+	// Read EQ token
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	if tok != lexer.EQ {
+		return "", NewParseError(tokstr(tok, lit), []string{"EQ"}, pos)
+	}
+
+	// Parse the option value
+	return p.parseEnum(allowed)
+}
+
+// parseEnum parses a bare or quoted value which must be one of the allowed
+// values. Values are matched case-insensitively and the allowed spelling is
+// returned.
+func (p *Parser) parseEnum(allowed []string) (string, error) {
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	if !isIdent(tok) && tok != lexer.STRING {
+		return "", NewParseError(tokstr(tok, lit), allowed, pos)
+	}
+
+	for _, value := range allowed {
+		if strings.EqualFold(lit, value) {
+			return value, nil
+		}
+	}
+	return "", NewParseError(lit, allowed, pos)
+}
+
+// parseCreateIndexStatement parses a string and returns a CreateIndexStatement.
+// This function assumes the "CREATE INDEX" tokens have already been consumed.
+func (p *Parser) parseCreateIndexStatement() (*CreateIndexStatement, error) {
+	stmt := &CreateIndexStatement{}
+
+	// Parse the index name and keyspace
+	name, ks, err := p.parseIndexOn()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Index = name
+	stmt.Keyspace = ks
+
+	// Read LPAREN token
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	if tok != lexer.LPAREN {
+		return nil, NewParseError(tokstr(tok, lit), []string{"LPAREN"}, pos)
+	}
+
+	// Indexed paths are always within the value
+	tok, pos, lit = p.scanIgnoreWhitespace()
+	if tok != tokens.VALUE {
+		return nil, NewParseError(tokstr(tok, lit), []string{"VALUE"}, pos)
+	}
+	tok, pos, lit = p.scan()
+	if tok != lexer.DOT {
+		return nil, NewParseError(tokstr(tok, lit), []string{"DOT"}, pos)
+	}
+
+	// Parse the value path
+	path, err := p.parsePath()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Path = path
+
+	// Read RPAREN token
+	tok, pos, lit = p.scanIgnoreWhitespace()
+	if tok != lexer.RPAREN {
+		return nil, NewParseError(tokstr(tok, lit), []string{"RPAREN"}, pos)
+	}
+
+	if err := p.parseEnd(); err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// parseCreateViewStatement parses a string and returns a CreateViewStatement.
+// This function assumes the "CREATE VIEW" tokens have already been consumed.
+func (p *Parser) parseCreateViewStatement() (*CreateViewStatement, error) {
+	stmt := &CreateViewStatement{}
+
+	// Parse the name of the view
+	view, err := p.parseKeyspace()
+	if err != nil {
+		return nil, err
+	}
+	stmt.View = view
+
+	// Read AS SELECT tokens
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	if tok != tokens.AS {
+		return nil, NewParseError(tokstr(tok, lit), []string{"AS"}, pos)
+	}
+	tok, pos, lit = p.scanIgnoreWhitespace()
+	if tok != tokens.SELECT {
+		return nil, NewParseError(tokstr(tok, lit), []string{"SELECT"}, pos)
+	}
+
+	// Parse the FROM clause
+	ks, err := p.parseFrom()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Keyspace = ks
+
+	// Read WITH token
+	tok, pos, lit = p.scanIgnoreWhitespace()
+	if tok != tokens.WITH {
+		return nil, NewParseError(tokstr(tok, lit), []string{"WITH"}, pos)
+	}
+
+	// Inspect the KEY or KEYS token.
+	tok, pos, lit = p.scanIgnoreWhitespace()
+	switch tok {
+	case tokens.KEY:
+		k, err := p.parseIdent()
+		if err != nil {
+			return nil, err
+		}
+		stmt.Keys = append(stmt.Keys, k)
+	case tokens.KEYS:
+		k, err := p.parseKeyList()
+		if err != nil {
+			return nil, err
+		}
+		stmt.Keys = k
+	default:
+		return nil, NewParseError(tokstr(tok, lit), []string{"KEY", "KEYS"}, pos)
+	}
+
+	if err := p.parseEnd(); err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// parseAlterStatement parses a string and returns an AST object.
+// This function assumes the "ALTER" token has already been consumed.
+func (p *Parser) parseAlterStatement() (Node, error) {
+
+	// Inspect the first token.
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	switch tok {
+	case tokens.KEYSPACE:
+		return p.parseAlterKeyspaceStatement()
+	default:
+		return nil, NewParseError(tokstr(tok, lit), []string{"KEYSPACE"}, pos)
+	}
+}
+
+// parseAlterKeyspaceStatement parses a string and returns an AST object.
+// This function assumes the "ALTER KEYSPACE" tokens have already been consumed.
+func (p *Parser) parseAlterKeyspaceStatement() (Node, error) {
+
+	// Parse the name of the keyspace to be altered
+	ks, err := p.parseKeyspace()
+	if err != nil {
+		return nil, err
+	}
+
+	// Inspect the ADD, RENAME or SET token.
+	var stmt Node
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	switch tok {
+	case tokens.ADD:
+		tok, pos, lit := p.scanIgnoreWhitespace()
+		if tok != tokens.KEY {
+			return nil, NewParseError(tokstr(tok, lit), []string{"KEY"}, pos)
+		}
+
+		key, err := p.parseIdent()
+		if err != nil {
+			return nil, err
+		}
+
+		// Read DEFAULT token
+		tok, pos, lit = p.scanIgnoreWhitespace()
+		if tok != tokens.DEFAULT {
+			return nil, NewParseError(tokstr(tok, lit), []string{"DEFAULT"}, pos)
+		}
+
+		value, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		stmt = &AlterAddKeyStatement{Keyspace: ks, Key: key, Default: value}
+	case tokens.RENAME:
+		tok, pos, lit := p.scanIgnoreWhitespace()
+		if tok != tokens.KEY {
+			return nil, NewParseError(tokstr(tok, lit), []string{"KEY"}, pos)
+		}
+
+		key, err := p.parseIdent()
+		if err != nil {
+			return nil, err
+		}
+
+		// Read TO token
+		tok, pos, lit = p.scanIgnoreWhitespace()
+		if tok != tokens.TO {
+			return nil, NewParseError(tokstr(tok, lit), []string{"TO"}, pos)
+		}
+
+		to, err := p.parseIdent()
+		if err != nil {
+			return nil, err
+		}
+		stmt = &AlterRenameKeyStatement{Keyspace: ks, Key: key, To: to}
+	case tokens.SET:
+
+		// Inspect the KEYS or option token.
+		tok, pos, lit := p.scanIgnoreWhitespace()
+		switch tok {
+		case tokens.KEYS:
+			keys, err := p.parseKeyList()
+			if err != nil {
+				return nil, err
+			}
+			stmt = &AlterKeysStatement{Keyspace: ks, Keys: keys}
+		case tokens.COMPACTION, tokens.COMPRESSION:
+			p.unscan()
+			alter := &AlterOptionsStatement{Keyspace: ks}
+
+			// Options are AND delimited
+			for {
+				if err := p.parseKeyspaceOption(&alter.Options); err != nil {
+					return nil, err
+				}
+				if tok, _, _ := p.scanIgnoreWhitespace(); tok != lexer.AND {
+					p.unscan()
+					break
+				}
+			}
+			stmt = alter
+		default:
+			return nil, NewParseError(tokstr(tok, lit), []string{"KEYS", "COMPACTION", "COMPRESSION"}, pos)
+		}
+	default:
+		return nil, NewParseError(tokstr(tok, lit), []string{"ADD", "RENAME", "SET"}, pos)
+	}
+
+	if err := p.parseEnd(); err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// parseDropStatement parses a string and returns a Statement AST object.
+// This function assumes the "DROP" token has already been consumed.
+func (p *Parser) parseDropStatement() (Node, error) {
+	// Inspect the first token.
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	switch tok {
+	case tokens.KEYSPACE:
+		return p.parseDropKeyspaceStatement()
+	case tokens.INDEX:
+		return p.parseDropIndexStatement()
+	default:
+		return nil, NewParseError(tokstr(tok, lit), []string{"KEYSPACE", "INDEX"}, pos)
+	}
+}
+
+// parseDropKeyspaceStatement parses a string and returns a DropStatement.
+// This function assumes the "DROP" token has already been consumed.
+func (p *Parser) parseDropKeyspaceStatement() (*DropStatement, error) {
+	stmt := &DropStatement{}
+
+	// Parse the name of the keyspace to be used
+	lit, err := p.parseKeyspace()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Keyspace = lit
+
+	return stmt, nil
+}
+
+// parseDropIndexStatement parses a string and returns a DropIndexStatement.
+// This function assumes the "DROP INDEX" tokens have already been consumed.
+func (p *Parser) parseDropIndexStatement() (*DropIndexStatement, error) {
+
+	// Parse the index name and keyspace
+	name, ks, err := p.parseIndexOn()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.parseEnd(); err != nil {
+		return nil, err
+	}
+	return &DropIndexStatement{Index: name, Keyspace: ks}, nil
+}
+
+// parseIndexOn parses an index name followed by the ON keyspace clause.
+func (p *Parser) parseIndexOn() (string, string, error) {
+
+	// Parse the index name
+	name, err := p.parseIdent()
+	if err != nil {
+		return "", "", err
+	}
+
+	// Read ON token
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	if tok != tokens.ON {
+		return "", "", NewParseError(tokstr(tok, lit), []string{"ON"}, pos)
+	}
+
+	// Parse keyspace name
+	ks, err := p.parseKeyspace()
+	if err != nil {
+		return "", "", err
+	}
+	return name, ks, nil
+}
+
+// parseSelectStatement parses a string and returns an AST object.
+// This function assumes the "SELECT" token has already been consumed.
+func (p *Parser) parseSelectStatement() (Node, error) {
+	stmt := &SelectStatement{}
+
+	// Parse the FROM clause
+	ks, err := p.parseFrom()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Keyspace = ks
+
+	// Inspect the AS or WHERE token.
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	switch tok {
+	case tokens.AS:
+		asOf, err := p.parseAsOf()
+		if err != nil {
+			return nil, err
+		}
+		stmt.AsOf = asOf
+
+		// Read WHERE token
+		tok, pos, lit := p.scanIgnoreWhitespace()
+		if tok != tokens.WHERE {
+			return nil, NewParseError(tokstr(tok, lit), []string{"WHERE"}, pos)
+		}
+	case tokens.WHERE:
+	default:
+		return nil, NewParseError(tokstr(tok, lit), []string{"AS", "WHERE"}, pos)
+	}
+
+	// Parse the WHERE clause
+	where, err := p.parseWhereClause(true, true)
+	if err != nil {
+		return nil, err
+	}
+	stmt.Where = where
+
+	if err := p.parseEnd(); err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// parseAsOf parses the point in time of an AS OF clause.
+// This function assumes the "AS" token has already been consumed.
+func (p *Parser) parseAsOf() (Node, error) {
+
+	// Read OF token
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	if tok != tokens.OF {
+		return nil, NewParseError(tokstr(tok, lit), []string{"OF"}, pos)
+	}
+
+	// Inspect the timestamp or sequence.
+	tok, pos, lit = p.scanIgnoreWhitespace()
+	switch tok {
+	case lexer.STRING:
+		if _, err := time.Parse(time.RFC3339Nano, lit); err != nil {
+			return nil, NewParseError(lit, []string{"RFC 3339 timestamp"}, pos)
+		}
+		return StringLiteral{lit}, nil
+	case lexer.NUMBER:
+		p.unscan()
+		seq, _, err := p.parseInteger(64)
+		if err != nil {
+			return nil, err
+		}
+		return NumberLiteral{seq}, nil
+	default:
+		return nil, NewParseError(tokstr(tok, lit), []string{"timestamp", "sequence"}, pos)
+	}
+}
+
+// parseDeleteStatement parses a string and returns an AST object.
+// This function assumes the "DELETE" token has already been consumed.
+func (p *Parser) parseDeleteStatement() (Node, error) {
+	ks, where, err := p.parseFromWhere()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.parseEnd(); err != nil {
+		return nil, err
+	}
+	return &DeleteStatement{
+		Keyspace: ks,
+		Where:    where,
+	}, nil
+}
+
+// parseWatchStatement parses a string and returns an AST object.
+// This function assumes the "WATCH" token has already been consumed.
+func (p *Parser) parseWatchStatement() (Node, error) {
+	ks, where, err := p.parseFromWhere()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.parseEnd(); err != nil {
+		return nil, err
+	}
+	return &WatchStatement{
+		Keyspace: ks,
+		Where:    where,
+	}, nil
+}
+
+// parseFromWhere parses the FROM and WHERE clauses of a statement.
+func (p *Parser) parseFromWhere() (string, []Expression, error) {
+
+	// Parse the FROM clause
+	keyspace, err := p.parseFrom()
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Inspect the WHERE token.
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	if tok != tokens.WHERE {
+		return "", nil, NewParseError(tokstr(tok, lit), []string{"WHERE"}, pos)
+	}
+
+	// Parse the WHERE clause
+	exprs, err := p.parseWhereClause(true, true)
+	if err != nil {
+		return "", nil, err
+	}
+	return keyspace, exprs, nil
+}
+
+// parseFrom parses the FROM token and returns the keyspace name.
+func (p *Parser) parseFrom() (string, error) {
+
+	// Inspect the FROM token.
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	if tok != tokens.FROM {
+		return "", NewParseError(tokstr(tok, lit), []string{"FROM"}, pos)
+	}
+
+	// Parse the name of the keyspace to be used
+	return p.parseKeyspace()
+}
+
+// parseUpsertStatement parses a string and returns an AST object.
+// This function assumes the "UPSERT" token has already been consumed.
+func (p *Parser) parseUpsertStatement() (Node, error) {
+	value, ks, where, err := p.parseIntoWhere(tokens.IF)
+	if err != nil {
+		return nil, err
+	}
+	stmt := &UpsertStatement{
+		Value:    value,
+		Keyspace: ks,
+		Where:    where,
+	}
+
+	// Parse the optional IF condition
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	if tok == tokens.IF {
+		cond, err := p.parseCondition()
+		if err != nil {
+			return nil, err
+		}
+		stmt.Condition = cond
+		tok, pos, lit = p.scanIgnoreWhitespace()
+	}
+
+	// Verify end of query
+	switch tok {
+	case lexer.EOF:
+	case lexer.SEMICOLON:
+	default:
+		return nil, NewParseError(tokstr(tok, lit), []string{"EOF", "SEMICOLON"}, pos)
+	}
+
+	return stmt, nil
+}
+
+// parseInsertStatement parses a string and returns an AST object.
+// This function assumes the "INSERT" token has already been consumed.
+func (p *Parser) parseInsertStatement() (Node, error) {
+	value, ks, where, err := p.parseIntoWhere()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.parseEnd(); err != nil {
+		return nil, err
+	}
+	return &InsertStatement{
+		Value:    value,
+		Keyspace: ks,
+		Where:    where,
+	}, nil
+}
+
+// parseUpdateStatement parses a string and returns an AST object.
+// This function assumes the "UPDATE" token has already been consumed.
+func (p *Parser) parseUpdateStatement() (Node, error) {
+	value, ks, where, err := p.parseIntoWhere()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.parseEnd(); err != nil {
+		return nil, err
+	}
+	return &UpdateStatement{
+		Value:    value,
+		Keyspace: ks,
+		Where:    where,
+	}, nil
+}
+
+// parsePatchStatement parses a string and returns an AST object.
+// This function assumes the "PATCH" token has already been consumed.
+func (p *Parser) parsePatchStatement() (Node, error) {
+
+	// Inspect the merge patch value or the INTO token.
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	switch tok {
+	case lexer.STRING:
+		p.unscan()
+		value, ks, where, err := p.parseIntoWhere()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.parseEnd(); err != nil {
+			return nil, err
+		}
+		return &PatchStatement{
+			Value:    value,
+			Keyspace: ks,
+			Where:    where,
+		}, nil
+	case tokens.INTO:
+	default:
+		return nil, NewParseError(tokstr(tok, lit), []string{"string", "INTO"}, pos)
+	}
+
+	// Parse keyspace name
+	ks, err := p.parseKeyspace()
+	if err != nil {
+		return nil, err
+	}
+
+	// Read SET token
+	tok, pos, lit = p.scanIgnoreWhitespace()
+	if tok != tokens.SET {
+		return nil, NewParseError(tokstr(tok, lit), []string{"SET"}, pos)
+	}
+
+	// Parse assignments
+	set, err := p.parseAssignmentList()
+	if err != nil {
+		return nil, err
+	}
+
+	// Read WHERE token
+	tok, pos, lit = p.scanIgnoreWhitespace()
+	if tok != tokens.WHERE {
+		return nil, NewParseError(tokstr(tok, lit), []string{"COMMA", "WHERE"}, pos)
+	}
+
+	// Parse WHERE clause
+	where, err := p.parseWhereClause(false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.parseEnd(); err != nil {
+		return nil, err
+	}
+	return &PatchStatement{
+		Set:      set,
+		Keyspace: ks,
+		Where:    where,
+	}, nil
+}
+
+// parseIncrementStatement parses a string and returns an AST object.
+// This function assumes the "INCREMENT" or "DECREMENT" token has already been consumed.
+func (p *Parser) parseIncrementStatement(decrement bool) (Node, error) {
+
+	// Parse keyspace name
+	ks, err := p.parseKeyspace()
+	if err != nil {
+		return nil, err
+	}
+
+	// Read BY token
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	if tok != tokens.BY {
+		return nil, NewParseError(tokstr(tok, lit), []string{"BY"}, pos)
+	}
+
+	// Parse the delta, which must fit in a signed integer
+	n, pos, err := p.parseInteger(63)
+	if err != nil {
+		return nil, err
+	} else if n == 0 {
+		return nil, &ParseError{Message: "BY must be greater than zero", Pos: pos}
+	}
+	delta := int64(n)
+	if decrement {
+		delta = -delta
+	}
+
+	// Read WHERE token
+	tok, pos, lit = p.scanIgnoreWhitespace()
+	if tok != tokens.WHERE {
+		return nil, NewParseError(tokstr(tok, lit), []string{"WHERE"}, pos)
+	}
+
+	// Parse WHERE clause
+	where, err := p.parseWhereClause(false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.parseEnd(); err != nil {
+		return nil, err
+	}
+	return &IncrementStatement{
+		Keyspace: ks,
+		Delta:    delta,
+		Where:    where,
+	}, nil
+}
+
+// parseBeginStatement parses a string and returns an AST object.
+// This function assumes the "BEGIN" token has already been consumed.
+func (p *Parser) parseBeginStatement() (Node, error) {
+	if err := p.parseEnd(); err != nil {
+		return nil, err
+	}
+	return &BeginStatement{}, nil
+}
+
+// parseCommitStatement parses a string and returns an AST object.
+// This function assumes the "COMMIT" token has already been consumed.
+func (p *Parser) parseCommitStatement() (Node, error) {
+	if err := p.parseEnd(); err != nil {
+		return nil, err
+	}
+	return &CommitStatement{}, nil
+}
+
+// parseRollbackStatement parses a string and returns an AST object.
+// This function assumes the "ROLLBACK" token has already been consumed.
+func (p *Parser) parseRollbackStatement() (Node, error) {
+	if err := p.parseEnd(); err != nil {
+		return nil, err
+	}
+	return &RollbackStatement{}, nil
+}
+
+// parseBatchStatement parses a string and returns an AST object.
+// This function assumes the "BATCH" token has already been consumed.
+func (p *Parser) parseBatchStatement() (Node, error) {
+	stmt := &BatchStatement{}
+
+	for {
+
+		// Inspect the next statement or the APPLY token.
+		tok, pos, lit := p.scanIgnoreWhitespace()
+		switch tok {
+		case tokens.UPSERT:
+			s, err := p.parseUpsertStatement()
+			if err != nil {
+				return nil, err
+			}
+			stmt.Statements = append(stmt.Statements, s)
+		case tokens.DELETE:
+			s, err := p.parseDeleteStatement()
+			if err != nil {
+				return nil, err
+			}
+			stmt.Statements = append(stmt.Statements, s)
+		case tokens.APPLY:
+			if len(stmt.Statements) > 0 {
+				if err := p.parseEnd(); err != nil {
+					return nil, err
+				}
+				return stmt, nil
+			}
+
+			// Batches can't be empty
+			return nil, NewParseError(tokstr(tok, lit), []string{"UPSERT", "DELETE"}, pos)
+		default:
+			if len(stmt.Statements) > 0 {
+				return nil, NewParseError(tokstr(tok, lit), []string{"UPSERT", "DELETE", "APPLY"}, pos)
+			}
+			return nil, NewParseError(tokstr(tok, lit), []string{"UPSERT", "DELETE"}, pos)
+		}
+	}
+}
+
+// parseBackupStatement parses a string and returns an AST object.
+// This function assumes the "BACKUP" token has already been consumed.
+func (p *Parser) parseBackupStatement() (Node, error) {
+
+	// Read TO token
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	if tok != tokens.TO {
+		return nil, NewParseError(tokstr(tok, lit), []string{"TO"}, pos)
+	}
+
+	// Parse the backup directory
+	path, err := p.parseString()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.parseEnd(); err != nil {
+		return nil, err
+	}
+	return &BackupStatement{Path: path}, nil
 }
 
-// ParseStatement parses a string and returns a Statement AST object.
-func (p *Parser) ParseStatement() (Node, error) {
+// parseRestoreStatement parses a string and returns an AST object.
+// This function assumes the "RESTORE" token has already been consumed.
+func (p *Parser) parseRestoreStatement() (Node, error) {
 
-	// Inspect the first token.
+	// Read FROM token
 	tok, pos, lit := p.scanIgnoreWhitespace()
-	switch tok {
-	case tokens.CREATE:
-		return p.parseCreateStatement()
-	case tokens.DROP:
-		return p.parseDropStatement()
-	case tokens.SELECT:
-		return p.parseSelectStatement()
-	case tokens.DELETE:
-		return p.parseDeleteStatement()
-	case tokens.UPSERT:
-		return p.parseUpsertStatement()
-	default:
-		return nil, NewParseError(tokstr(tok, lit), []string{"CREATE", "DROP", "SELECT", "DELETE", "UPSERT"}, pos)
+	if tok != tokens.FROM {
+		return nil, NewParseError(tokstr(tok, lit), []string{"FROM"}, pos)
+	}
+
+	// Parse the backup directory
+	path, err := p.parseString()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.parseEnd(); err != nil {
+		return nil, err
 	}
+	return &RestoreStatement{Path: path}, nil
 }
 
-// parseCreateStatement parses a string and returns an AST object.
-// This function assumes the "CREATE" token has already been consumed.
-func (p *Parser) parseCreateStatement() (Node, error) {
+// parseRenameStatement parses a string and returns an AST object.
+// This function assumes the "RENAME" token has already been consumed.
+func (p *Parser) parseRenameStatement() (Node, error) {
 
 	// Inspect the first token.
 	tok, pos, lit := p.scanIgnoreWhitespace()
 	switch tok {
 	case tokens.KEYSPACE:
-		return p.parseCreateKeyspaceStatement()
+		ks, to, err := p.parseKeyspaceTo()
+		if err != nil {
+			return nil, err
+		}
+		return &RenameKeyspaceStatement{Keyspace: ks, To: to}, nil
 	default:
 		return nil, NewParseError(tokstr(tok, lit), []string{"KEYSPACE"}, pos)
 	}
 }
 
-// parseCreateKeyspaceStatement parses a string and returns a CreateKeyspaceStatement.
-// This function assumes the "CREATE" token has already been consumed.
-func (p *Parser) parseCreateKeyspaceStatement() (*CreateStatement, error) {
-	stmt := &CreateStatement{}
-
-	// Parse the name of the keyspace to be used
-	lit, err := p.parseKeyspace()
-	if err != nil {
-		return nil, err
-	}
-	stmt.Keyspace = lit
+// parseShowStatement parses a string and returns an AST object.
+// This function assumes the "SHOW" token has already been consumed.
+func (p *Parser) parseShowStatement() (Node, error) {
 
-	// Inspect the WITH token.
+	// Inspect the first token.
 	tok, pos, lit := p.scanIgnoreWhitespace()
 	switch tok {
-	case tokens.WITH:
+	case tokens.KEYSPACES:
+		stmt := &ShowKeyspacesStatement{}
 
-		// Inspect the KEY or KEYS token.
-		tok, pos, lit := p.scanIgnoreWhitespace()
-		switch tok {
-		case tokens.KEY:
-			k, err := p.parseIdent()
+		// Parse the optional LIKE pattern
+		if tok, _, _ := p.scanIgnoreWhitespace(); tok == tokens.LIKE {
+			like, err := p.parseString()
 			if err != nil {
 				return nil, err
-			} else {
-				stmt.Keys = append(stmt.Keys, k)
 			}
-		case tokens.KEYS:
-			k, err := p.parseIdentList()
+			stmt.Like = like
+		} else {
+			p.unscan()
+		}
+
+		if err := p.parseEnd(); err != nil {
+			return nil, err
+		}
+		return stmt, nil
+	case tokens.INDEXES:
+		stmt := &ShowIndexesStatement{}
+
+		// Parse the optional ON keyspace
+		if tok, _, _ := p.scanIgnoreWhitespace(); tok == tokens.ON {
+			ks, err := p.parseKeyspace()
 			if err != nil {
 				return nil, err
-			} else {
-				stmt.Keys = k
 			}
-		default:
-			return nil, NewParseError(tokstr(tok, lit), []string{"KEY", "KEYS"}, pos)
+			stmt.Keyspace = ks
+		} else {
+			p.unscan()
 		}
-	default:
-		return nil, NewParseError(tokstr(tok, lit), []string{"WITH"}, pos)
-	}
 
-	// Verify end of query
-	tok, pos, lit = p.scanIgnoreWhitespace()
-	switch tok {
-	case lexer.EOF:
-	case lexer.SEMICOLON:
+		if err := p.parseEnd(); err != nil {
+			return nil, err
+		}
+		return stmt, nil
+	case tokens.STATS:
+		if err := p.parseEnd(); err != nil {
+			return nil, err
+		}
+		return &ShowStatsStatement{}, nil
 	default:
-		return nil, NewParseError(tokstr(tok, lit), []string{"EOF", "SEMICOLON"}, pos)
+		return nil, NewParseError(tokstr(tok, lit), []string{"KEYSPACES", "INDEXES", "STATS"}, pos)
 	}
-
-	return stmt, nil
 }
 
-// parseDropStatement parses a string and returns a Statement AST object.
-// This function assumes the "DROP" token has already been consumed.
-func (p *Parser) parseDropStatement() (Node, error) {
+// parseDescribeStatement parses a string and returns an AST object.
+// This function assumes the "DESCRIBE" token has already been consumed.
+func (p *Parser) parseDescribeStatement() (Node, error) {
+
 	// Inspect the first token.
 	tok, pos, lit := p.scanIgnoreWhitespace()
 	switch tok {
 	case tokens.KEYSPACE:
-		return p.parseDropKeyspaceStatement()
+		ks, err := p.parseKeyspace()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.parseEnd(); err != nil {
+			return nil, err
+		}
+		return &DescribeKeyspaceStatement{Keyspace: ks}, nil
 	default:
 		return nil, NewParseError(tokstr(tok, lit), []string{"KEYSPACE"}, pos)
 	}
 }
 
-// parseDropKeyspaceStatement parses a string and returns a DropStatement.
-// This function assumes the "DROP" token has already been consumed.
-func (p *Parser) parseDropKeyspaceStatement() (*DropStatement, error) {
-	stmt := &DropStatement{}
+// parseKeyspaceTo parses the source and destination keyspaces of a
+// RENAME KEYSPACE or COPY KEYSPACE statement and verifies the end of the query.
+func (p *Parser) parseKeyspaceTo() (string, string, error) {
 
-	// Parse the name of the keyspace to be used
-	lit, err := p.parseKeyspace()
+	// Parse the source keyspace name
+	ks, err := p.parseKeyspace()
 	if err != nil {
-		return nil, err
+		return "", "", err
 	}
-	stmt.Keyspace = lit
 
-	return stmt, nil
-}
-
-// parseSelectStatement parses a string and returns an AST object.
-// This function assumes the "SELECT" token has already been consumed.
-func (p *Parser) parseSelectStatement() (Node, error) {
+	// Read TO token
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	if tok != tokens.TO {
+		return "", "", NewParseError(tokstr(tok, lit), []string{"TO"}, pos)
+	}
 
-	ks, where, err := p.parseFromWhere()
+	// Parse the destination keyspace name
+	to, err := p.parseKeyspace()
 	if err != nil {
-		return nil, err
+		return "", "", err
 	}
 
-	return &SelectStatement{
-		Keyspace: ks,
-		Where:    where,
-	}, nil
+	if err := p.parseEnd(); err != nil {
+		return "", "", err
+	}
+	return ks, to, nil
 }
 
-// parseDeleteStatement parses a string and returns an AST object.
-// This function assumes the "DELETE" token has already been consumed.
-func (p *Parser) parseDeleteStatement() (Node, error) {
-	ks, where, err := p.parseFromWhere()
+// parseCopyStatement parses a string and returns an AST object.
+// This function assumes the "COPY" token has already been consumed.
+func (p *Parser) parseCopyStatement() (Node, error) {
+
+	// Inspect the KEYSPACE token.
+	if tok, _, _ := p.scanIgnoreWhitespace(); tok == tokens.KEYSPACE {
+		ks, to, err := p.parseKeyspaceTo()
+		if err != nil {
+			return nil, err
+		}
+		return &CopyKeyspaceStatement{Keyspace: ks, To: to}, nil
+	}
+	p.unscan()
+
+	// Parse keyspace name
+	ks, err := p.parseKeyspace()
 	if err != nil {
 		return nil, err
 	}
 
-	return &DeleteStatement{
-		Keyspace: ks,
-		Where:    where,
-	}, nil
-}
-
-func (p *Parser) parseFromWhere() (string, []Expression, error) {
-	var keyspace string
-	var exprs []Expression
-
-	// Inspect the FROM token.
+	// Inspect the FROM or TO token.
 	tok, pos, lit := p.scanIgnoreWhitespace()
-	switch tok {
-	case tokens.FROM:
+	if tok != tokens.FROM && tok != tokens.TO {
+		return nil, NewParseError(tokstr(tok, lit), []string{"FROM", "TO"}, pos)
+	}
+	from := tok == tokens.FROM
 
-		// Parse the name of the keyspace to be used
-		lit, err := p.parseKeyspace()
-		if err != nil {
-			return "", nil, err
-		}
-		keyspace = lit
+	// Parse the file name
+	path, err := p.parseString()
+	if err != nil {
+		return nil, err
+	}
 
-		// Inspect the WHERE token.
-		tok, pos, lit := p.scanIgnoreWhitespace()
-		switch tok {
-		case tokens.WHERE:
+	// Read FORMAT token
+	tok, pos, lit = p.scanIgnoreWhitespace()
+	if tok != tokens.FORMAT {
+		return nil, NewParseError(tokstr(tok, lit), []string{"FORMAT"}, pos)
+	}
 
-			// Parse the WHERE clause
-			exp, err := p.parseWhereClause(true, true)
-			if err != nil {
-				return "", nil, err
-			}
-			exprs = exp
+	// Parse the file format
+	format, err := p.parseEnum(copyFormats)
+	if err != nil {
+		return nil, err
+	}
 
-		default:
-			return "", nil, NewParseError(tokstr(tok, lit), []string{"WHERE"}, pos)
-		}
+	if err := p.parseEnd(); err != nil {
+		return nil, err
+	}
 
-	default:
-		return "", nil, NewParseError(tokstr(tok, lit), []string{"FROM"}, pos)
+	if from {
+		return &CopyFromStatement{Keyspace: ks, Path: path, Format: format}, nil
 	}
-	return keyspace, exprs, nil
+	return &CopyToStatement{Keyspace: ks, Path: path, Format: format}, nil
 }
 
-// parseUpsertStatement parses a string and returns an AST object.
-// This function assumes the "UPSERT" token has already been consumed.
-func (p *Parser) parseUpsertStatement() (Node, error) {
+// parseIntoWhere parses the value, keyspace and WHERE clause of a write.
+// Any terminators are left unconsumed at the end of the WHERE clause.
+func (p *Parser) parseIntoWhere(terminators ...lexer.Token) (string, string, []Expression, error) {
 
 	// Parse value
 	value, err := p.parseString()
 	if err != nil {
-		return nil, err
+		return "", "", nil, err
 	}
 
 	// Read INTO token
 	tok, pos, lit := p.scanIgnoreWhitespace()
 	if tok != tokens.INTO {
-		return nil, NewParseError(tokstr(tok, lit), []string{"INTO"}, pos)
+		return "", "", nil, NewParseError(tokstr(tok, lit), []string{"INTO"}, pos)
 	}
 
 	// Parse keyspace name
 	ks, err := p.parseKeyspace()
 	if err != nil {
-		return nil, err
+		return "", "", nil, err
 	}
 
 	// Read WHERE token
 	tok, pos, lit = p.scanIgnoreWhitespace()
 	if tok != tokens.WHERE {
-		return nil, NewParseError(tokstr(tok, lit), []string{"WHERE"}, pos)
+		return "", "", nil, NewParseError(tokstr(tok, lit), []string{"WHERE"}, pos)
 	}
 
 	// Parse WHERE clause
-	where, err := p.parseWhereClause(false, false)
+	where, err := p.parseWhereClause(false, false, terminators...)
 	if err != nil {
-		return nil, err
+		return "", "", nil, err
 	}
+	return value, ks, where, nil
+}
 
-	return &UpsertStatement{
-		Value:    value,
-		Keyspace: ks,
-		Where:    where,
-	}, nil
+// parseCondition parses a compare-and-set condition.
+// This function assumes the "IF" token has already been consumed.
+func (p *Parser) parseCondition() (Node, error) {
+
+	// Inspect the VALUE or VERSION token.
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	switch tok {
+	case tokens.VALUE:
+		tok, pos, lit := p.scanIgnoreWhitespace()
+		if tok != lexer.EQ {
+			return nil, NewParseError(tokstr(tok, lit), []string{"EQ"}, pos)
+		}
+
+		value, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		return ValueCondition{Value: value}, nil
+	case tokens.VERSION:
+		tok, pos, lit := p.scanIgnoreWhitespace()
+		if tok != lexer.EQ {
+			return nil, NewParseError(tokstr(tok, lit), []string{"EQ"}, pos)
+		}
+
+		version, _, err := p.parseInteger(64)
+		if err != nil {
+			return nil, err
+		}
+		return VersionCondition{Version: version}, nil
+	default:
+		return nil, NewParseError(tokstr(tok, lit), []string{"VALUE", "VERSION"}, pos)
+	}
 }
 
 // parseWhereClause parses a string and returns an AST object.
 // This function assumes the "WHERE" token has already been consumed.
-func (p *Parser) parseWhereClause(allowBetween bool, allowLogicalOR bool) ([]Expression, error) {
+// The token ending the clause is left unconsumed.
+func (p *Parser) parseWhereClause(allowBetween bool, allowLogicalOR bool, terminators ...lexer.Token) ([]Expression, error) {
 	var expr []Expression
 
 	// Read expression
-	exp, err := p.parseExpression(allowBetween, allowLogicalOR)
+	exp, err := p.parseExpression(allowBetween, allowLogicalOR, terminators...)
 	if err != nil {
 		return expr, err
 	}
@@ -266,28 +1237,33 @@ OUTER:
 		tok, pos, lit := p.scanIgnoreWhitespace()
 		switch tok {
 		case lexer.EOF, lexer.SEMICOLON:
+			p.unscan()
 			break OUTER
 		case lexer.AND:
 
-			exp, err := p.parseExpression(allowBetween, allowLogicalOR)
+			exp, err := p.parseExpression(allowBetween, allowLogicalOR, terminators...)
 			if err != nil {
 				return expr, err
 			}
 			expr = append(expr, exp)
 
 		default:
-			return nil, NewParseError(tokstr(tok, lit), []string{"EOF", "SEMICOLON", "AND"}, pos)
+			if hasToken(terminators, tok) {
+				p.unscan()
+				break OUTER
+			}
+			return nil, NewParseError(tokstr(tok, lit), append([]string{"EOF", "SEMICOLON", "AND"}, tokstrs(terminators)...), pos)
 		}
 	}
 	return expr, nil
 }
 
 // parseExpression parses a string and returns an AST object.
-func (p *Parser) parseExpression(allowBetween, allowLogicalOR bool) (Expression, error) {
+func (p *Parser) parseExpression(allowBetween, allowLogicalOR bool, terminators ...lexer.Token) (Expression, error) {
 
-	// Inspect the FROM token.
+	// Inspect the key attribute token.
 	tok, pos, lit := p.scanIgnoreWhitespace()
-	if tok != lexer.IDENT {
+	if !isIdent(tok) {
 		return nil, NewParseError(tokstr(tok, lit), []string{"identifier"}, pos)
 	}
 	ident := lit
@@ -296,7 +1272,7 @@ func (p *Parser) parseExpression(allowBetween, allowLogicalOR bool) (Expression,
 	tok, pos, lit = p.scanIgnoreWhitespace()
 	switch tok {
 	case lexer.EQ:
-		expr, err := p.parseEqualityExpression(ident, allowLogicalOR)
+		expr, err := p.parseEqualityExpression(ident, allowLogicalOR, terminators...)
 		if err != nil {
 			return nil, err
 		}
@@ -320,7 +1296,7 @@ func (p *Parser) parseExpression(allowBetween, allowLogicalOR bool) (Expression,
 }
 
 // parseEqualityExpression parses a string and returns an AST object.
-func (p *Parser) parseEqualityExpression(ident string, allowLogicalOR bool) (Expression, error) {
+func (p *Parser) parseEqualityExpression(ident string, allowLogicalOR bool, terminators ...lexer.Token) (Expression, error) {
 	// expr := &EqualityExpression{KeyAttribute: ident}
 	// var expr Expression
 
@@ -333,7 +1309,7 @@ func (p *Parser) parseEqualityExpression(ident string, allowLogicalOR bool) (Exp
 	// Inspect the AND / OR token.
 	tok, pos, lit := p.scanIgnoreWhitespace()
 	switch tok {
-	case lexer.AND, lexer.EOF:
+	case lexer.AND, lexer.EOF, lexer.SEMICOLON:
 		p.unscan()
 		return EqualityExpression{KeyAttribute: ident, Value: StringLiteral{value}}, nil
 	case lexer.OR:
@@ -352,7 +1328,11 @@ func (p *Parser) parseEqualityExpression(ident string, allowLogicalOR bool) (Exp
 		values = append(values, value)
 		return EqualityExpression{KeyAttribute: ident, Value: StringLiteralGroup{Operator: OrOperator, Values: values}}, nil
 	default:
-		return nil, NewParseError(tokstr(tok, lit), []string{"AND", "OR"}, pos)
+		if hasToken(terminators, tok) {
+			p.unscan()
+			return EqualityExpression{KeyAttribute: ident, Value: StringLiteral{value}}, nil
+		}
+		return nil, NewParseError(tokstr(tok, lit), append([]string{"AND", "OR"}, tokstrs(terminators)...), pos)
 	}
 }
 
@@ -385,25 +1365,68 @@ func (p *Parser) parseBetweenExpression(ident string) (Expression, error) {
 	return expr, nil
 }
 
+// parseAssignmentList returns a list of assignments or an error
+func (p *Parser) parseAssignmentList() ([]Assignment, error) {
+	var assignments []Assignment
+	for {
+		path, err := p.parsePath()
+		if err != nil {
+			return nil, err
+		}
+
+		// Read EQ token
+		tok, pos, lit := p.scanIgnoreWhitespace()
+		if tok != lexer.EQ {
+			return nil, NewParseError(tokstr(tok, lit), []string{"EQ"}, pos)
+		}
+
+		// Read the JSON value
+		tok, pos, lit = p.scanIgnoreWhitespace()
+		if tok != lexer.STRING {
+			return nil, NewParseError(tokstr(tok, lit), []string{"string"}, pos)
+		} else if !json.Valid([]byte(lit)) {
+			return nil, NewParseError(lit, []string{"JSON"}, pos)
+		}
+		assignments = append(assignments, Assignment{Path: path, Value: lit})
+
+		// Assignments are comma delimited
+		if tok, _, _ := p.scanIgnoreWhitespace(); tok != lexer.COMMA {
+			p.unscan()
+			return assignments, nil
+		}
+	}
+}
+
 // parseKeyspace returns a keyspace title or an error
 func (p *Parser) parseKeyspace() (string, error) {
-	var keyspace string
+	return p.parseDottedIdent("keyspace")
+}
+
+// parsePath returns a value path or an error
+func (p *Parser) parsePath() (string, error) {
+	return p.parseDottedIdent("path")
+}
+
+// parseDottedIdent returns a period delimited list of identifiers or an error.
+// The name describes what is expected if no identifier is found.
+func (p *Parser) parseDottedIdent(name string) (string, error) {
+	var ident string
 	tok, pos, lit := p.scanIgnoreWhitespace()
-	if tok != lexer.IDENT {
-		return "", NewParseError(tokstr(tok, lit), []string{"keyspace"}, pos)
+	if !isIdent(tok) {
+		return "", NewParseError(tokstr(tok, lit), []string{name}, pos)
 	}
-	keyspace = lit
+	ident = lit
 
-	// Scan entire keyspace
-	// Keyspaces are a period delimited list of identifiers
+	// Scan entire identifier
+	// Identifiers are a period delimited list of identifiers
 	var endPeriod bool
 	for {
 		tok, pos, lit = p.scan()
 		if tok == lexer.DOT {
-			keyspace += "."
+			ident += "."
 			endPeriod = true
-		} else if tok == lexer.IDENT {
-			keyspace += lit
+		} else if isIdent(tok) {
+			ident += lit
 			endPeriod = false
 		} else {
 			break
@@ -413,11 +1436,23 @@ func (p *Parser) parseKeyspace() (string, error) {
 	// remove last token
 	p.unscan()
 
-	// Keyspaces can't end on a period
+	// Identifiers can't end on a period
 	if endPeriod {
 		return "", NewParseError(tokstr(tok, lit), []string{"identifier"}, pos)
 	}
-	return keyspace, nil
+	return ident, nil
+}
+
+// parseEnd verifies the end of the query.
+func (p *Parser) parseEnd() error {
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	switch tok {
+	case lexer.EOF:
+	case lexer.SEMICOLON:
+	default:
+		return NewParseError(tokstr(tok, lit), []string{"EOF", "SEMICOLON"}, pos)
+	}
+	return nil
 }
 
 // parserString parses a string.
@@ -429,21 +1464,61 @@ func (p *Parser) parseString() (string, error) {
 	return lit, nil
 }
 
+// parseInteger parses an unsigned integer that fits in bitSize bits and
+// returns it along with its position.
+func (p *Parser) parseInteger(bitSize int) (uint64, lexer.Pos, error) {
+	tok, pos, lit := p.scanIgnoreWhitespace()
+	if tok != lexer.NUMBER {
+		return 0, pos, NewParseError(tokstr(tok, lit), []string{"integer"}, pos)
+	}
+
+	n, err := strconv.ParseUint(lit, 10, bitSize)
+	if err != nil {
+		return 0, pos, NewParseError(lit, []string{"integer"}, pos)
+	}
+	return n, pos, nil
+}
+
 // parseIdent parses an identifier.
 func (p *Parser) parseIdent() (string, error) {
 	tok, pos, lit := p.scanIgnoreWhitespace()
-	if tok != lexer.IDENT {
+	if !isIdent(tok) {
 		p.unscan()
 		return "", NewParseError(tokstr(tok, lit), []string{"identifier"}, pos)
 	}
 	return lit, nil
 }
 
+// parseKeyList returns a list of unique key attributes or an error
+func (p *Parser) parseKeyList() ([]string, error) {
+	var keys []string
+	for {
+		tok, pos, lit := p.scanIgnoreWhitespace()
+		if !isIdent(tok) {
+			return nil, NewParseError(tokstr(tok, lit), []string{"identifier"}, pos)
+		}
+
+		// Each key attribute may only be listed once
+		for _, k := range keys {
+			if k == lit {
+				return nil, &ParseError{Message: "duplicate key " + lit, Pos: pos}
+			}
+		}
+		keys = append(keys, lit)
+
+		// Key lists are comma delimited
+		if tok, _, _ := p.scanIgnoreWhitespace(); tok != lexer.COMMA {
+			p.unscan()
+			return keys, nil
+		}
+	}
+}
+
 // parseIdentList returns a list of attributes or an error
 func (p *Parser) parseIdentList() ([]string, error) {
 	var keys []string
 	tok, pos, lit := p.scanIgnoreWhitespace()
-	if tok != lexer.IDENT {
+	if !isIdent(tok) {
 		return keys, NewParseError(tokstr(tok, lit), []string{"identifier"}, pos)
 	}
 	keys = append(keys, lit)
@@ -451,11 +1526,9 @@ func (p *Parser) parseIdentList() ([]string, error) {
 	// Scan entire list
 	// Key lists are comma delimited
 	for {
-		tok, pos, lit = p.scanIgnoreWhitespace()
-		if tok == lexer.EOF {
+		tok, _, _ = p.scanIgnoreWhitespace()
+		if tok != lexer.COMMA {
 			break
-		} else if tok != lexer.COMMA {
-			return keys, NewParseError(tokstr(tok, lit), []string{"COMMA", "EOF"}, pos)
 		}
 
 		k, err := p.parseIdent()
@@ -489,6 +1562,32 @@ func (p *Parser) scanIgnoreWhitespace() (tok lexer.Token, pos lexer.Pos, lit str
 	return
 }
 
+// hasToken returns true if the token is in the list.
+func hasToken(toks []lexer.Token, tok lexer.Token) bool {
+	for _, t := range toks {
+		if t == tok {
+			return true
+		}
+	}
+	return false
+}
+
+// tokstrs returns the token strings of a list of tokens.
+func tokstrs(toks []lexer.Token) []string {
+	var strs []string
+	for _, tok := range toks {
+		strs = append(strs, tok.String())
+	}
+	return strs
+}
+
+// isIdent returns true if the token can be used as an identifier.
+// Soft keywords never start or end a clause, so they are also valid
+// identifiers.
+func isIdent(tok lexer.Token) bool {
+	return tok == lexer.IDENT || hasToken(softKeywords, tok)
+}
+
 // tokstr returns a literal if provided, otherwise returns the token string.
 func tokstr(tok lexer.Token, lit string) string {
 	if tok == lexer.IDENT {
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"encoding/json"
 	"reflect"
 	"strings"
 	"testing"
@@ -53,12 +54,32 @@ func (suite *ParserTestSuite) TestInvalidStatement() {
 	var tests = []TestCase{
 
 		// Errors
-		{s: `a bad statement.`, err: `found IDENTIFIER (a), expected CREATE, DROP, SELECT, DELETE, UPSERT at line 1, char 1`},
+		{s: `a bad statement.`, err: `found IDENTIFIER (a), expected CREATE, DROP, SELECT, DELETE, UPSERT, INSERT, UPDATE, PATCH, INCREMENT, DECREMENT, BEGIN, COMMIT, ROLLBACK, BATCH, BACKUP, RESTORE, COPY, WATCH, ALTER, RENAME, SHOW, DESCRIBE at line 1, char 1`},
 	}
 
 	suite.validate(tests)
 }
 
+// Ensure the parser can parse several statements from a single reader
+func (suite *ParserTestSuite) TestMultipleStatements() {
+	p := NewParser(strings.NewReader(`SELECT FROM users WHERE timestamp BETWEEN "2015-01-01" AND "2016-01-01";
+		DELETE FROM users WHERE username = "bugs.bunny";
+		INSERT "{...}" INTO users WHERE username = "bugs.bunny";
+		PATCH INTO users SET age = "25" WHERE username = "bugs.bunny";
+		INCREMENT views BY 1 WHERE page = "home";
+		WATCH FROM users WHERE username = "bugs.bunny";`))
+
+	var exp = []NodeType{SelectType, DeleteType, InsertType, PatchType, IncrementType, WatchType}
+	for i, typ := range exp {
+		stmt, err := p.ParseStatement()
+		if err != nil {
+			suite.T().Errorf("%d. unexpected error: %s", i, err)
+		} else if stmt.NodeType() != typ {
+			suite.T().Errorf("%d. node type mismatch: exp=%d got=%d", i, typ, stmt.NodeType())
+		}
+	}
+}
+
 // Ensure the parser can parse strings into CREATE KEYSPACE statements
 func (suite *ParserTestSuite) TestCreateKeyspace() {
 	var tests = []TestCase{
@@ -74,20 +95,76 @@ func (suite *ParserTestSuite) TestCreateKeyspace() {
 			s:    `CREATE KEYSPACE acme WITH KEYS id`,
 			stmt: &CreateStatement{Keyspace: "acme", Keys: []string{"id"}},
 		},
+		{
+			s:    `CREATE KEYSPACE acme WITH KEYS id, category;`,
+			stmt: &CreateStatement{Keyspace: "acme", Keys: []string{"id", "category"}},
+		},
+		{
+			s: `CREATE KEYSPACE events WITH KEYS timestamp, source AND COMPACTION = "time_window"`,
+			stmt: &CreateStatement{
+				Keyspace: "events",
+				Keys:     []string{"timestamp", "source"},
+				Options:  KeyspaceOptions{Compaction: TimeWindowCompaction},
+			},
+		},
+		{
+			s: `CREATE KEYSPACE events WITH KEYS timestamp, source AND COMPRESSION = "zstd" AND COMPACTION = "time_window"`,
+			stmt: &CreateStatement{
+				Keyspace: "events",
+				Keys:     []string{"timestamp", "source"},
+				Options:  KeyspaceOptions{Compaction: TimeWindowCompaction, Compression: ZstdCompression},
+			},
+		},
+		{
+			s: `CREATE KEYSPACE users WITH KEY username AND COMPACTION = "leveled"`,
+			stmt: &CreateStatement{
+				Keyspace: "users",
+				Keys:     []string{"username"},
+				Options:  KeyspaceOptions{Compaction: LeveledCompaction},
+			},
+		},
+		{
+			s: `CREATE KEYSPACE users WITH KEY username AND COMPACTION = "LEVELED" AND COMPRESSION = zstd`,
+			stmt: &CreateStatement{
+				Keyspace: "users",
+				Keys:     []string{"username"},
+				Options:  KeyspaceOptions{Compaction: LeveledCompaction, Compression: ZstdCompression},
+			},
+		},
+
+		{
+			s:    `CREATE KEYSPACE docs WITH KEYS id, version, value`,
+			stmt: &CreateStatement{Keyspace: "docs", Keys: []string{"id", "version", "value"}},
+		},
+		{
+			s:    `CREATE KEYSPACE docs WITH KEY version`,
+			stmt: &CreateStatement{Keyspace: "docs", Keys: []string{"version"}},
+		},
 
 		// Errors
-		{s: `CREATE `, err: `found EOF, expected KEYSPACE at line 1, char 9`},
+		{s: `CREATE `, err: `found EOF, expected KEYSPACE, INDEX, VIEW at line 1, char 9`},
 		{s: `CREATE KEYSPACE `, err: `found EOF, expected keyspace at line 1, char 18`},
 		{s: `CREATE KEYSPACE acme.example.`, err: `found EOF, expected identifier at line 1, char 30`},
 		{s: `CREATE KEYSPACE acme.example. `, err: `found WS, expected identifier at line 1, char 30`},
 		{s: `CREATE KEYSPACE .example`, err: `found ., expected keyspace at line 1, char 17`},
+		{s: `CREATE KEYSPACE keyspace WITH KEY id`, err: `found KEYSPACE, expected keyspace at line 1, char 17`},
+		{s: `CREATE KEYSPACE acme WITH KEYS id, where`, err: `found WHERE, expected identifier at line 1, char 36`},
 		{s: `CREATE KEYSPACE acme`, err: `found EOF, expected WITH at line 1, char 22`},
 		{s: `CREATE KEYSPACE acme WITH`, err: `found EOF, expected KEY, KEYS at line 1, char 27`},
 		{s: `CREATE KEYSPACE acme WITH KEY`, err: `found EOF, expected identifier at line 1, char 31`},
 		{s: `CREATE KEYSPACE acme WITH KEYS`, err: `found EOF, expected identifier at line 1, char 32`},
 		{s: `CREATE KEYSPACE acme WITH KEYS id,`, err: `found EOF, expected identifier at line 1, char 35`},
 		{s: `CREATE KEYSPACE acme WITH KEYS id, ""`, err: `found TEXTUAL, expected identifier at line 1, char 35`},
-		{s: `CREATE KEYSPACE acme WITH KEY id,`, err: `found ,, expected EOF, SEMICOLON at line 1, char 33`},
+		{s: `CREATE KEYSPACE acme WITH KEYS id category`, err: `found IDENTIFIER (category), expected AND, EOF, SEMICOLON at line 1, char 35`},
+		{s: `CREATE KEYSPACE acme WITH KEY id AND`, err: `found EOF, expected COMPACTION, COMPRESSION at line 1, char 38`},
+		{s: `CREATE KEYSPACE acme WITH KEY id AND COMPACTION`, err: `found EOF, expected EQ at line 1, char 49`},
+		{s: `CREATE KEYSPACE acme WITH KEY id AND COMPACTION =`, err: `found EOF, expected leveled, size_tiered, time_window at line 1, char 50`},
+		{s: `CREATE KEYSPACE acme WITH KEY id AND COMPACTION = 1`, err: `found NUMBER, expected leveled, size_tiered, time_window at line 1, char 51`},
+		{s: `CREATE KEYSPACE acme WITH KEY id AND COMPACTION = "fastest"`, err: `found fastest, expected leveled, size_tiered, time_window at line 1, char 50`},
+		{s: `CREATE KEYSPACE acme WITH KEY id AND COMPACTION = "leveled" AND COMPACTION = "leveled"`, err: `duplicate COMPACTION option at line 1, char 65`},
+		{s: `CREATE KEYSPACE acme WITH KEY id AND COMPRESSION = "gzip"`, err: `found gzip, expected none, snappy, zstd at line 1, char 51`},
+		{s: `CREATE KEYSPACE acme WITH KEY id AND COMPRESSION = "zstd" AND COMPRESSION = "none"`, err: `duplicate COMPRESSION option at line 1, char 63`},
+		{s: `CREATE KEYSPACE acme WITH KEY id,`, err: `found ,, expected AND, EOF, SEMICOLON at line 1, char 33`},
 	}
 
 	suite.validate(tests)
@@ -102,7 +179,7 @@ func (suite *ParserTestSuite) TestDropKeyspace() {
 		},
 
 		// Errors
-		{s: `DROP `, err: `found EOF, expected KEYSPACE at line 1, char 7`},
+		{s: `DROP `, err: `found EOF, expected KEYSPACE, INDEX at line 1, char 7`},
 		{s: `DROP KEYSPACE `, err: `found EOF, expected keyspace at line 1, char 16`},
 		{s: `DROP KEYSPACE acme.example.`, err: `found EOF, expected identifier at line 1, char 28`},
 		{s: `DROP KEYSPACE acme.example. `, err: `found WS, expected identifier at line 1, char 28`},
@@ -112,6 +189,187 @@ func (suite *ParserTestSuite) TestDropKeyspace() {
 	suite.validate(tests)
 }
 
+// Ensure the parser can parse strings into CREATE INDEX and DROP INDEX statements
+func (suite *ParserTestSuite) TestIndexStatements() {
+	var tests = []TestCase{
+		{
+			s:    `CREATE INDEX email ON users (value.email)`,
+			stmt: &CreateIndexStatement{Index: "email", Keyspace: "users", Path: "email"},
+		},
+		{
+			s:    `CREATE INDEX city ON acme.users (VALUE.address.city);`,
+			stmt: &CreateIndexStatement{Index: "city", Keyspace: "acme.users", Path: "address.city"},
+		},
+		{
+			s:    `DROP INDEX email ON users`,
+			stmt: &DropIndexStatement{Index: "email", Keyspace: "users"},
+		},
+		{
+			s:    `CREATE INDEX v ON docs (value.version)`,
+			stmt: &CreateIndexStatement{Index: "v", Keyspace: "docs", Path: "version"},
+		},
+		{
+			s:    `CREATE INDEX k ON docs (value.key)`,
+			stmt: &CreateIndexStatement{Index: "k", Keyspace: "docs", Path: "key"},
+		},
+		{
+			s:    `CREATE INDEX index ON docs (value.index)`,
+			stmt: &CreateIndexStatement{Index: "index", Keyspace: "docs", Path: "index"},
+		},
+
+		// Errors
+		{s: `CREATE INDEX`, err: `found EOF, expected identifier at line 1, char 14`},
+		{s: `CREATE INDEX email`, err: `found EOF, expected ON at line 1, char 20`},
+		{s: `CREATE INDEX email ON`, err: `found EOF, expected keyspace at line 1, char 23`},
+		{s: `CREATE INDEX email ON users`, err: `found EOF, expected LPAREN at line 1, char 29`},
+		{s: `CREATE INDEX email ON users (email)`, err: `found IDENTIFIER (email), expected VALUE at line 1, char 30`},
+		{s: `CREATE INDEX email ON users (value)`, err: `found ), expected DOT at line 1, char 35`},
+		{s: `CREATE INDEX email ON users (value.)`, err: `found ), expected path at line 1, char 36`},
+		{s: `CREATE INDEX email ON users (value.email`, err: `found EOF, expected RPAREN at line 1, char 42`},
+		{s: `CREATE INDEX email ON users (value.email) users`, err: `found IDENTIFIER (users), expected EOF, SEMICOLON at line 1, char 43`},
+		{s: `DROP INDEX`, err: `found EOF, expected identifier at line 1, char 12`},
+		{s: `DROP INDEX email`, err: `found EOF, expected ON at line 1, char 18`},
+		{s: `DROP INDEX email ON users users`, err: `found IDENTIFIER (users), expected EOF, SEMICOLON at line 1, char 27`},
+	}
+
+	suite.validate(tests)
+}
+
+// Ensure the parser can parse strings into CREATE VIEW statements
+func (suite *ParserTestSuite) TestCreateViewStatement() {
+	var tests = []TestCase{
+		{
+			s:    `CREATE VIEW users.by_time AS SELECT FROM users WITH KEYS timestamp, username`,
+			stmt: &CreateViewStatement{View: "users.by_time", Keyspace: "users", Keys: []string{"timestamp", "username"}},
+		},
+		{
+			s:    `CREATE VIEW by_topic AS SELECT FROM users WITH KEY topic;`,
+			stmt: &CreateViewStatement{View: "by_topic", Keyspace: "users", Keys: []string{"topic"}},
+		},
+
+		// Errors
+		{s: `CREATE VIEW`, err: `found EOF, expected keyspace at line 1, char 13`},
+		{s: `CREATE VIEW by_time`, err: `found EOF, expected AS at line 1, char 21`},
+		{s: `CREATE VIEW by_time AS`, err: `found EOF, expected SELECT at line 1, char 24`},
+		{s: `CREATE VIEW by_time AS SELECT`, err: `found EOF, expected FROM at line 1, char 31`},
+		{s: `CREATE VIEW by_time AS SELECT FROM users`, err: `found EOF, expected WITH at line 1, char 42`},
+		{s: `CREATE VIEW by_time AS SELECT FROM users WITH`, err: `found EOF, expected KEY, KEYS at line 1, char 47`},
+		{s: `CREATE VIEW by_time AS SELECT FROM users WITH KEYS`, err: `found EOF, expected identifier at line 1, char 52`},
+		{s: `CREATE VIEW by_time AS SELECT FROM users WITH KEYS timestamp, timestamp`, err: `duplicate key timestamp at line 1, char 63`},
+		{s: `CREATE VIEW by_time AS SELECT FROM users WITH KEYS timestamp username`, err: `found IDENTIFIER (username), expected EOF, SEMICOLON at line 1, char 62`},
+	}
+
+	suite.validate(tests)
+}
+
+// Ensure the parser can parse strings into ALTER KEYSPACE statements
+func (suite *ParserTestSuite) TestAlterKeyspace() {
+	var tests = []TestCase{
+		{
+			s:    `ALTER KEYSPACE users ADD KEY region DEFAULT "us-east"`,
+			stmt: &AlterAddKeyStatement{Keyspace: "users", Key: "region", Default: "us-east"},
+		},
+		{
+			s:    `ALTER KEYSPACE acme.users RENAME KEY username TO login;`,
+			stmt: &AlterRenameKeyStatement{Keyspace: "acme.users", Key: "username", To: "login"},
+		},
+		{
+			s:    `ALTER KEYSPACE users SET COMPACTION = "leveled"`,
+			stmt: &AlterOptionsStatement{Keyspace: "users", Options: KeyspaceOptions{Compaction: LeveledCompaction}},
+		},
+		{
+			s: `ALTER KEYSPACE users SET COMPRESSION = "snappy" AND COMPACTION = "size_tiered"`,
+			stmt: &AlterOptionsStatement{
+				Keyspace: "users",
+				Options:  KeyspaceOptions{Compaction: SizeTieredCompaction, Compression: SnappyCompression},
+			},
+		},
+		{
+			s:    `ALTER KEYSPACE events SET KEYS source, timestamp`,
+			stmt: &AlterKeysStatement{Keyspace: "events", Keys: []string{"source", "timestamp"}},
+		},
+		{
+			s:    `ALTER KEYSPACE docs ADD KEY version DEFAULT "1"`,
+			stmt: &AlterAddKeyStatement{Keyspace: "docs", Key: "version", Default: "1"},
+		},
+
+		// Errors
+		{s: `ALTER`, err: `found EOF, expected KEYSPACE at line 1, char 7`},
+		{s: `ALTER KEYSPACE`, err: `found EOF, expected keyspace at line 1, char 16`},
+		{s: `ALTER KEYSPACE users`, err: `found EOF, expected ADD, RENAME, SET at line 1, char 22`},
+		{s: `ALTER KEYSPACE users ADD`, err: `found EOF, expected KEY at line 1, char 26`},
+		{s: `ALTER KEYSPACE users ADD KEY`, err: `found EOF, expected identifier at line 1, char 30`},
+		{s: `ALTER KEYSPACE users ADD KEY region`, err: `found EOF, expected DEFAULT at line 1, char 37`},
+		{s: `ALTER KEYSPACE users ADD KEY region DEFAULT`, err: `found EOF, expected string at line 1, char 45`},
+		{s: `ALTER KEYSPACE users ADD KEY region DEFAULT "us-east" AND`, err: `found AND, expected EOF, SEMICOLON at line 1, char 55`},
+		{s: `ALTER KEYSPACE users RENAME KEY username`, err: `found EOF, expected TO at line 1, char 42`},
+		{s: `ALTER KEYSPACE users RENAME KEY username TO`, err: `found EOF, expected identifier at line 1, char 45`},
+		{s: `ALTER KEYSPACE users SET`, err: `found EOF, expected KEYS, COMPACTION, COMPRESSION at line 1, char 26`},
+		{s: `ALTER KEYSPACE users SET KEYS`, err: `found EOF, expected identifier at line 1, char 31`},
+		{s: `ALTER KEYSPACE users SET KEYS region, username, region`, err: `duplicate key region at line 1, char 49`},
+		{s: `ALTER KEYSPACE users SET KEYS region, username AND`, err: `found AND, expected EOF, SEMICOLON at line 1, char 48`},
+		{s: `ALTER KEYSPACE users SET COMPACTION = "leveled" AND`, err: `found EOF, expected COMPACTION, COMPRESSION at line 1, char 53`},
+		{s: `ALTER KEYSPACE users SET COMPACTION = "leveled" AND COMPACTION = "leveled"`, err: `duplicate COMPACTION option at line 1, char 53`},
+	}
+
+	suite.validate(tests)
+}
+
+// Ensure the parser can parse strings into RENAME KEYSPACE and COPY KEYSPACE statements
+func (suite *ParserTestSuite) TestRenameCopyKeyspace() {
+	var tests = []TestCase{
+		{
+			s:    `RENAME KEYSPACE acme.example TO acme.prod.example`,
+			stmt: &RenameKeyspaceStatement{Keyspace: "acme.example", To: "acme.prod.example"},
+		},
+		{
+			s:    `COPY KEYSPACE acme.example TO acme.staging.example;`,
+			stmt: &CopyKeyspaceStatement{Keyspace: "acme.example", To: "acme.staging.example"},
+		},
+
+		// Errors
+		{s: `RENAME`, err: `found EOF, expected KEYSPACE at line 1, char 8`},
+		{s: `RENAME KEYSPACE`, err: `found EOF, expected keyspace at line 1, char 17`},
+		{s: `RENAME KEYSPACE acme`, err: `found EOF, expected TO at line 1, char 22`},
+		{s: `RENAME KEYSPACE acme TO`, err: `found EOF, expected keyspace at line 1, char 25`},
+		{s: `RENAME KEYSPACE acme TO acme.`, err: `found EOF, expected identifier at line 1, char 30`},
+		{s: `RENAME KEYSPACE acme TO prod users`, err: `found IDENTIFIER (users), expected EOF, SEMICOLON at line 1, char 30`},
+		{s: `COPY KEYSPACE`, err: `found EOF, expected keyspace at line 1, char 15`},
+		{s: `COPY KEYSPACE acme`, err: `found EOF, expected TO at line 1, char 20`},
+		{s: `COPY KEYSPACE acme FROM prod`, err: `found FROM, expected TO at line 1, char 20`},
+	}
+
+	suite.validate(tests)
+}
+
+// Ensure the parser can parse strings into SHOW and DESCRIBE statements
+func (suite *ParserTestSuite) TestShowDescribeStatements() {
+	var tests = []TestCase{
+		{s: `SHOW KEYSPACES`, stmt: &ShowKeyspacesStatement{}},
+		{s: `SHOW KEYSPACES LIKE "acme.%";`, stmt: &ShowKeyspacesStatement{Like: "acme.%"}},
+		{s: `SHOW INDEXES`, stmt: &ShowIndexesStatement{}},
+		{s: `SHOW INDEXES ON acme.users`, stmt: &ShowIndexesStatement{Keyspace: "acme.users"}},
+		{s: `SHOW STATS`, stmt: &ShowStatsStatement{}},
+		{s: `DESCRIBE KEYSPACE acme.users`, stmt: &DescribeKeyspaceStatement{Keyspace: "acme.users"}},
+		{s: `DESCRIBE KEYSPACE acme.stats`, stmt: &DescribeKeyspaceStatement{Keyspace: "acme.stats"}},
+		{s: `SHOW INDEXES ON indexes`, stmt: &ShowIndexesStatement{Keyspace: "indexes"}},
+
+		// Errors
+		{s: `SHOW`, err: `found EOF, expected KEYSPACES, INDEXES, STATS at line 1, char 6`},
+		{s: `SHOW KEYSPACE`, err: `found KEYSPACE, expected KEYSPACES, INDEXES, STATS at line 1, char 6`},
+		{s: `SHOW KEYSPACES LIKE`, err: `found EOF, expected string at line 1, char 21`},
+		{s: `SHOW KEYSPACES LIKE acme`, err: `found IDENTIFIER (acme), expected string at line 1, char 21`},
+		{s: `SHOW KEYSPACES acme`, err: `found IDENTIFIER (acme), expected EOF, SEMICOLON at line 1, char 16`},
+		{s: `SHOW INDEXES ON`, err: `found EOF, expected keyspace at line 1, char 17`},
+		{s: `SHOW STATS users`, err: `found IDENTIFIER (users), expected EOF, SEMICOLON at line 1, char 12`},
+		{s: `DESCRIBE`, err: `found EOF, expected KEYSPACE at line 1, char 10`},
+		{s: `DESCRIBE KEYSPACE`, err: `found EOF, expected keyspace at line 1, char 19`},
+		{s: `DESCRIBE KEYSPACE acme users`, err: `found IDENTIFIER (users), expected EOF, SEMICOLON at line 1, char 24`},
+	}
+
+	suite.validate(tests)
+}
+
 // Ensure the parser can parse strings into SELECT statements
 func (suite *ParserTestSuite) TestSelectStatement() {
 	var tests = []TestCase{
@@ -126,6 +384,17 @@ func (suite *ParserTestSuite) TestSelectStatement() {
 				},
 			},
 		},
+		{
+			s: `SELECT FROM users WHERE username = "bugs.bunny";`,
+			stmt: &SelectStatement{Keyspace: "users",
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "username",
+						Value:        StringLiteral{"bugs.bunny"},
+					},
+				},
+			},
+		},
 		{
 			s: `SELECT FROM users WHERE username = "bugs.bunny" OR "daffy.duck"`,
 			stmt: &SelectStatement{Keyspace: "users",
@@ -203,11 +472,88 @@ func (suite *ParserTestSuite) TestSelectStatement() {
 			},
 		},
 
+		{
+			s: `SELECT FROM docs WHERE version = "1" AND value = "2"`,
+			stmt: &SelectStatement{Keyspace: "docs",
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "version",
+						Value:        StringLiteral{"1"},
+					},
+					EqualityExpression{
+						KeyAttribute: "value",
+						Value:        StringLiteral{"2"},
+					},
+				},
+			},
+		},
+		{
+			s: `SELECT FROM docs WHERE index = "1"`,
+			stmt: &SelectStatement{Keyspace: "docs",
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "index",
+						Value:        StringLiteral{"1"},
+					},
+				},
+			},
+		},
+		{
+			s: `SELECT FROM users WHERE default = "1"`,
+			stmt: &SelectStatement{Keyspace: "users",
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "default",
+						Value:        StringLiteral{"1"},
+					},
+				},
+			},
+		},
+		{
+			s: `SELECT FROM acme.stats WHERE id = "1"`,
+			stmt: &SelectStatement{Keyspace: "acme.stats",
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "id",
+						Value:        StringLiteral{"1"},
+					},
+				},
+			},
+		},
+		{
+			s: `SELECT FROM keyspaces WHERE like = "1" AND stats = "2"`,
+			stmt: &SelectStatement{Keyspace: "keyspaces",
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "like",
+						Value:        StringLiteral{"1"},
+					},
+					EqualityExpression{
+						KeyAttribute: "stats",
+						Value:        StringLiteral{"2"},
+					},
+				},
+			},
+		},
+		{
+			s: `SELECT FROM files WHERE format = "csv"`,
+			stmt: &SelectStatement{Keyspace: "files",
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "format",
+						Value:        StringLiteral{"csv"},
+					},
+				},
+			},
+		},
+
 		// Errors
 		{s: `SELECT`, err: `found EOF, expected FROM at line 1, char 8`},
 		{s: `SELECT FROM `, err: `found EOF, expected keyspace at line 1, char 14`},
-		{s: `SELECT FROM users`, err: `found EOF, expected WHERE at line 1, char 19`},
+		{s: `SELECT FROM users`, err: `found EOF, expected AS, WHERE at line 1, char 19`},
 		{s: `SELECT FROM users WHERE`, err: `found EOF, expected identifier at line 1, char 25`},
+		{s: `SELECT FROM WHERE a = "b"`, err: `found WHERE, expected keyspace at line 1, char 13`},
+		{s: `SELECT FROM users WHERE from = "b"`, err: `found FROM, expected identifier at line 1, char 25`},
 		{s: `SELECT FROM users WHERE username`, err: `found EOF, expected EQ, BETWEEN at line 1, char 34`},
 		{s: `SELECT FROM users WHERE username =`, err: `found EOF, expected string at line 1, char 35`},
 		{s: `SELECT FROM users WHERE username = "bugs.bunny" OR`, err: `found EOF, expected string at line 1, char 52`},
@@ -221,6 +567,55 @@ func (suite *ParserTestSuite) TestSelectStatement() {
 	suite.validate(tests)
 }
 
+// Ensure the parser can parse strings into SELECT ... AS OF statements
+func (suite *ParserTestSuite) TestSelectAsOfStatement() {
+	var tests = []TestCase{
+		{
+			s: `SELECT FROM users AS OF "2015-06-01T00:00:00Z" WHERE username = "bugs.bunny"`,
+			stmt: &SelectStatement{Keyspace: "users",
+				AsOf: StringLiteral{"2015-06-01T00:00:00Z"},
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "username",
+						Value:        StringLiteral{"bugs.bunny"},
+					},
+				},
+			},
+		},
+		{
+			s: `SELECT FROM users AS OF 1024 WHERE username = "bugs.bunny" AND timestamp BETWEEN "2015-01-01" AND "2016-01-01"`,
+			stmt: &SelectStatement{Keyspace: "users",
+				AsOf: NumberLiteral{1024},
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "username",
+						Value:        StringLiteral{"bugs.bunny"},
+					},
+					BetweenExpression{
+						KeyAttribute: "timestamp",
+						Values: StringLiteralGroup{
+							Values:   []string{"2015-01-01", "2016-01-01"},
+							Operator: AndOperator,
+						},
+					},
+				},
+			},
+		},
+
+		// Errors
+		{s: `SELECT FROM users AS`, err: `found EOF, expected OF at line 1, char 22`},
+		{s: `SELECT FROM users AS OF`, err: `found EOF, expected timestamp, sequence at line 1, char 25`},
+		{s: `SELECT FROM users AS OF users`, err: `found IDENTIFIER (users), expected timestamp, sequence at line 1, char 25`},
+		{s: `SELECT FROM users AS OF 1.5 WHERE username = "bugs.bunny"`, err: `found 1.5, expected integer at line 1, char 25`},
+		{s: `SELECT FROM users AS OF "banana" WHERE username = "bugs.bunny"`, err: `found banana, expected RFC 3339 timestamp at line 1, char 24`},
+		{s: `SELECT FROM users AS OF "2015-06-01" WHERE username = "bugs.bunny"`, err: `found 2015-06-01, expected RFC 3339 timestamp at line 1, char 24`},
+		{s: `SELECT FROM users AS OF "2015-06-01T00:00:00Z" username = "bugs.bunny"`, err: `found IDENTIFIER (username), expected WHERE at line 1, char 48`},
+		{s: `DELETE FROM users AS OF 1024 WHERE username = "bugs.bunny"`, err: `found AS, expected WHERE at line 1, char 19`},
+	}
+
+	suite.validate(tests)
+}
+
 // Ensure the parser can parse strings into UPSERT statements
 func (suite *ParserTestSuite) TestUpsertStatement() {
 	var tests = []TestCase{
@@ -277,6 +672,497 @@ func (suite *ParserTestSuite) TestUpsertStatement() {
 	suite.validate(tests)
 }
 
+// Ensure the parser can parse strings into conditional UPSERT statements
+func (suite *ParserTestSuite) TestConditionalUpsertStatement() {
+	var tests = []TestCase{
+		{
+			s: `UPSERT "{...}" INTO users WHERE username = "bugs.bunny" IF VALUE = "{}"`,
+			stmt: &UpsertStatement{
+				Value:    "{...}",
+				Keyspace: "users",
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "username",
+						Value:        StringLiteral{"bugs.bunny"},
+					},
+				},
+				Condition: ValueCondition{Value: "{}"},
+			},
+		},
+		{
+			s: `UPSERT "{...}" INTO users.convo WHERE username = "bugs.bunny" AND convo_id = "5" IF VERSION = 3`,
+			stmt: &UpsertStatement{
+				Value:    "{...}",
+				Keyspace: "users.convo",
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "username",
+						Value:        StringLiteral{"bugs.bunny"},
+					},
+					EqualityExpression{
+						KeyAttribute: "convo_id",
+						Value:        StringLiteral{"5"},
+					},
+				},
+				Condition: VersionCondition{Version: 3},
+			},
+		},
+
+		{
+			s: `UPSERT "{...}" INTO docs WHERE id = "1" AND version = "2" IF VERSION = 2`,
+			stmt: &UpsertStatement{
+				Value:    "{...}",
+				Keyspace: "docs",
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "id",
+						Value:        StringLiteral{"1"},
+					},
+					EqualityExpression{
+						KeyAttribute: "version",
+						Value:        StringLiteral{"2"},
+					},
+				},
+				Condition: VersionCondition{Version: 2},
+			},
+		},
+
+		// Errors
+		{s: `UPSERT "..." INTO users WHERE username = "bugs.bunny" IF`, err: `found EOF, expected VALUE, VERSION at line 1, char 58`},
+		{s: `UPSERT "..." INTO users WHERE username = "bugs.bunny" IF VALUE`, err: `found EOF, expected EQ at line 1, char 64`},
+		{s: `UPSERT "..." INTO users WHERE username = "bugs.bunny" IF VALUE =`, err: `found EOF, expected string at line 1, char 65`},
+		{s: `UPSERT "..." INTO users WHERE username = "bugs.bunny" IF VERSION = "3"`, err: `found TEXTUAL, expected integer at line 1, char 67`},
+		{s: `UPSERT "..." INTO users WHERE username = "bugs.bunny" IF VERSION = 3 IF`, err: `found IF, expected EOF, SEMICOLON at line 1, char 70`},
+		{s: `UPSERT "..." INTO users WHERE username = "bugs.bunny" IF username = "bugs.bunny"`, err: `found IDENTIFIER (username), expected VALUE, VERSION at line 1, char 58`},
+		{s: `UPSERT "..." INTO users WHERE username = "bugs.bunny" AND convo_id = "5" OR`, err: `OR not allowed at line 1, char 74`},
+		{s: `UPSERT "..." INTO users WHERE username = "bugs.bunny" timestamp`, err: `found IDENTIFIER (timestamp), expected AND, OR, IF at line 1, char 55`},
+	}
+
+	suite.validate(tests)
+}
+
+// Ensure the parser can parse strings into INSERT statements
+func (suite *ParserTestSuite) TestInsertStatement() {
+	var tests = []TestCase{
+		{
+			s: `INSERT "{...}" INTO users WHERE username = "bugs.bunny"`,
+			stmt: &InsertStatement{
+				Value:    "{...}",
+				Keyspace: "users",
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "username",
+						Value:        StringLiteral{"bugs.bunny"},
+					},
+				},
+			},
+		},
+
+		// Errors
+		{s: `INSERT`, err: `found EOF, expected string at line 1, char 8`},
+		{s: `INSERT "..." INTO users`, err: `found EOF, expected WHERE at line 1, char 25`},
+		{s: `INSERT "..." INTO users WHERE username = "bugs.bunny" OR`, err: `OR not allowed at line 1, char 55`},
+		{s: `INSERT "..." INTO users WHERE username = "bugs.bunny" IF VERSION = 3`, err: `found IF, expected AND, OR at line 1, char 55`},
+	}
+
+	suite.validate(tests)
+}
+
+// Ensure the parser can parse strings into UPDATE statements
+func (suite *ParserTestSuite) TestUpdateStatement() {
+	var tests = []TestCase{
+		{
+			s: `UPDATE "{...}" INTO users WHERE username = "bugs.bunny"`,
+			stmt: &UpdateStatement{
+				Value:    "{...}",
+				Keyspace: "users",
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "username",
+						Value:        StringLiteral{"bugs.bunny"},
+					},
+				},
+			},
+		},
+
+		// Errors
+		{s: `UPDATE`, err: `found EOF, expected string at line 1, char 8`},
+		{s: `UPDATE "..." INTO users`, err: `found EOF, expected WHERE at line 1, char 25`},
+		{s: `UPDATE "..." INTO users WHERE username = "bugs.bunny" AND timestamp BETWEEN`, err: `BETWEEN not allowed at line 1, char 69`},
+	}
+
+	suite.validate(tests)
+}
+
+// Ensure the parser can parse strings into PATCH statements
+func (suite *ParserTestSuite) TestPatchStatement() {
+	var tests = []TestCase{
+		{
+			s: `PATCH "{...}" INTO users WHERE username = "bugs.bunny"`,
+			stmt: &PatchStatement{
+				Value:    "{...}",
+				Keyspace: "users",
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "username",
+						Value:        StringLiteral{"bugs.bunny"},
+					},
+				},
+			},
+		},
+		{
+			s: `PATCH INTO users SET address.city = "\"New York\"" WHERE username = "bugs.bunny"`,
+			stmt: &PatchStatement{
+				Set: []Assignment{
+					{Path: "address.city", Value: `"New York"`},
+				},
+				Keyspace: "users",
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "username",
+						Value:        StringLiteral{"bugs.bunny"},
+					},
+				},
+			},
+		},
+		{
+			s: `PATCH INTO users.convo SET age = "25", address.state = "\"NY\"" WHERE username = "bugs.bunny" AND convo_id = "5"`,
+			stmt: &PatchStatement{
+				Set: []Assignment{
+					{Path: "age", Value: "25"},
+					{Path: "address.state", Value: `"NY"`},
+				},
+				Keyspace: "users.convo",
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "username",
+						Value:        StringLiteral{"bugs.bunny"},
+					},
+					EqualityExpression{
+						KeyAttribute: "convo_id",
+						Value:        StringLiteral{"5"},
+					},
+				},
+			},
+		},
+
+		{
+			s: `PATCH INTO users SET version = "2", meta.key = "\"k\"" WHERE id = "1"`,
+			stmt: &PatchStatement{
+				Set: []Assignment{
+					{Path: "version", Value: "2"},
+					{Path: "meta.key", Value: `"k"`},
+				},
+				Keyspace: "users",
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "id",
+						Value:        StringLiteral{"1"},
+					},
+				},
+			},
+		},
+
+		// Errors
+		{s: `PATCH`, err: `found EOF, expected string, INTO at line 1, char 7`},
+		{s: `PATCH "..."`, err: `found EOF, expected INTO at line 1, char 12`},
+		{s: `PATCH "..." INTO users WHERE username = "bugs.bunny" OR`, err: `OR not allowed at line 1, char 54`},
+		{s: `PATCH INTO`, err: `found EOF, expected keyspace at line 1, char 12`},
+		{s: `PATCH INTO users`, err: `found EOF, expected SET at line 1, char 18`},
+		{s: `PATCH INTO users SET`, err: `found EOF, expected path at line 1, char 22`},
+		{s: `PATCH INTO users SET address.`, err: `found EOF, expected identifier at line 1, char 30`},
+		{s: `PATCH INTO users SET age`, err: `found EOF, expected EQ at line 1, char 26`},
+		{s: `PATCH INTO users SET age =`, err: `found EOF, expected string at line 1, char 27`},
+		{s: `PATCH INTO users SET address.city = "New York" WHERE username = "bugs.bunny"`, err: `found New York, expected JSON at line 1, char 36`},
+		{s: `PATCH INTO users SET age = "25", address = "{\"city\":" WHERE username = "bugs.bunny"`, err: `found {"city":, expected JSON at line 1, char 43`},
+		{s: `PATCH INTO users SET age = "25"`, err: `found EOF, expected COMMA, WHERE at line 1, char 32`},
+		{s: `PATCH INTO users SET age = "25",`, err: `found EOF, expected path at line 1, char 33`},
+		{s: `PATCH INTO users SET age = "25" WHERE username = "bugs.bunny" AND timestamp BETWEEN`, err: `BETWEEN not allowed at line 1, char 77`},
+	}
+
+	suite.validate(tests)
+}
+
+// Ensure the parser can parse strings into INCREMENT and DECREMENT statements
+func (suite *ParserTestSuite) TestIncrementStatement() {
+	var tests = []TestCase{
+		{
+			s: `INCREMENT views BY 1 WHERE page = "home"`,
+			stmt: &IncrementStatement{
+				Keyspace: "views",
+				Delta:    1,
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "page",
+						Value:        StringLiteral{"home"},
+					},
+				},
+			},
+		},
+		{
+			s: `DECREMENT views.daily BY 5 WHERE page = "home" AND day = "2015-01-01"`,
+			stmt: &IncrementStatement{
+				Keyspace: "views.daily",
+				Delta:    -5,
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "page",
+						Value:        StringLiteral{"home"},
+					},
+					EqualityExpression{
+						KeyAttribute: "day",
+						Value:        StringLiteral{"2015-01-01"},
+					},
+				},
+			},
+		},
+
+		// Errors
+		{s: `INCREMENT`, err: `found EOF, expected keyspace at line 1, char 11`},
+		{s: `INCREMENT views`, err: `found EOF, expected BY at line 1, char 17`},
+		{s: `INCREMENT views BY`, err: `found EOF, expected integer at line 1, char 20`},
+		{s: `INCREMENT views BY "1"`, err: `found TEXTUAL, expected integer at line 1, char 19`},
+		{s: `INCREMENT views BY 1.5 WHERE page = "home"`, err: `found 1.5, expected integer at line 1, char 20`},
+		{s: `INCREMENT views BY 9223372036854775808 WHERE page = "home"`, err: `found 9223372036854775808, expected integer at line 1, char 20`},
+		{s: `INCREMENT views BY 0 WHERE page = "home"`, err: `BY must be greater than zero at line 1, char 20`},
+		{s: `DECREMENT views BY 0 WHERE page = "home"`, err: `BY must be greater than zero at line 1, char 20`},
+		{s: `DECREMENT views BY 1 page = "home"`, err: `found IDENTIFIER (page), expected WHERE at line 1, char 22`},
+		{s: `DECREMENT views BY 1 WHERE page = "home" OR`, err: `OR not allowed at line 1, char 42`},
+	}
+
+	suite.validate(tests)
+}
+
+// Ensure the parser can parse strings into transaction statements
+func (suite *ParserTestSuite) TestTransactionStatements() {
+	var tests = []TestCase{
+		{s: `BEGIN`, stmt: &BeginStatement{}},
+		{s: `BEGIN;`, stmt: &BeginStatement{}},
+		{s: `COMMIT`, stmt: &CommitStatement{}},
+		{s: `ROLLBACK;`, stmt: &RollbackStatement{}},
+
+		// Errors
+		{s: `BEGIN users`, err: `found IDENTIFIER (users), expected EOF, SEMICOLON at line 1, char 7`},
+		{s: `COMMIT users`, err: `found IDENTIFIER (users), expected EOF, SEMICOLON at line 1, char 8`},
+		{s: `ROLLBACK users`, err: `found IDENTIFIER (users), expected EOF, SEMICOLON at line 1, char 10`},
+	}
+
+	suite.validate(tests)
+}
+
+// Ensure the parser can parse strings into BATCH statements
+func (suite *ParserTestSuite) TestBatchStatement() {
+	var tests = []TestCase{
+		{
+			s: `BATCH UPSERT "{...}" INTO users WHERE username = "bugs.bunny"; APPLY`,
+			stmt: &BatchStatement{
+				Statements: []Node{
+					&UpsertStatement{
+						Value:    "{...}",
+						Keyspace: "users",
+						Where: []Expression{
+							EqualityExpression{
+								KeyAttribute: "username",
+								Value:        StringLiteral{"bugs.bunny"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			s: `BATCH
+				DELETE FROM users WHERE username = "bugs.bunny";
+				UPSERT "{...}" INTO users WHERE username = "daffy.duck" IF VERSION = 2;
+				DELETE FROM users WHERE username = "porky.pig" AND timestamp BETWEEN "2015-01-01" AND "2016-01-01";
+			APPLY;`,
+			stmt: &BatchStatement{
+				Statements: []Node{
+					&DeleteStatement{
+						Keyspace: "users",
+						Where: []Expression{
+							EqualityExpression{
+								KeyAttribute: "username",
+								Value:        StringLiteral{"bugs.bunny"},
+							},
+						},
+					},
+					&UpsertStatement{
+						Value:    "{...}",
+						Keyspace: "users",
+						Where: []Expression{
+							EqualityExpression{
+								KeyAttribute: "username",
+								Value:        StringLiteral{"daffy.duck"},
+							},
+						},
+						Condition: VersionCondition{Version: 2},
+					},
+					&DeleteStatement{
+						Keyspace: "users",
+						Where: []Expression{
+							EqualityExpression{
+								KeyAttribute: "username",
+								Value:        StringLiteral{"porky.pig"},
+							},
+							BetweenExpression{
+								KeyAttribute: "timestamp",
+								Values: StringLiteralGroup{
+									Values:   []string{"2015-01-01", "2016-01-01"},
+									Operator: AndOperator,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		// Errors
+		{s: `BATCH`, err: `found EOF, expected UPSERT, DELETE at line 1, char 7`},
+		{s: `BATCH APPLY`, err: `found APPLY, expected UPSERT, DELETE at line 1, char 7`},
+		{s: `BATCH SELECT FROM users WHERE username = "bugs.bunny"; APPLY`, err: `found SELECT, expected UPSERT, DELETE at line 1, char 7`},
+		{s: `BATCH DELETE FROM users WHERE username = "bugs.bunny";`, err: `found EOF, expected UPSERT, DELETE, APPLY at line 1, char 55`},
+		{s: `BATCH DELETE FROM users WHERE username = "bugs.bunny"; INSERT "{...}" INTO users WHERE username = "bugs.bunny"; APPLY`, err: `found INSERT, expected UPSERT, DELETE, APPLY at line 1, char 56`},
+		{s: `BATCH DELETE FROM users WHERE username = "bugs.bunny"; APPLY users`, err: `found IDENTIFIER (users), expected EOF, SEMICOLON at line 1, char 62`},
+	}
+
+	suite.validate(tests)
+}
+
+// Ensure the parser can parse strings into BACKUP and RESTORE statements
+func (suite *ParserTestSuite) TestBackupRestoreStatements() {
+	var tests = []TestCase{
+		{s: `BACKUP TO "/var/backups/prefixdb"`, stmt: &BackupStatement{Path: "/var/backups/prefixdb"}},
+		{s: `RESTORE FROM "/var/backups/prefixdb";`, stmt: &RestoreStatement{Path: "/var/backups/prefixdb"}},
+
+		// Errors
+		{s: `BACKUP`, err: `found EOF, expected TO at line 1, char 8`},
+		{s: `BACKUP TO`, err: `found EOF, expected string at line 1, char 11`},
+		{s: `BACKUP TO backups`, err: `found IDENTIFIER (backups), expected string at line 1, char 11`},
+		{s: `BACKUP FROM "/var/backups/prefixdb"`, err: `found FROM, expected TO at line 1, char 8`},
+		{s: `RESTORE`, err: `found EOF, expected FROM at line 1, char 9`},
+		{s: `RESTORE FROM`, err: `found EOF, expected string at line 1, char 14`},
+		{s: `RESTORE TO "/var/backups/prefixdb"`, err: `found TO, expected FROM at line 1, char 9`},
+	}
+
+	suite.validate(tests)
+}
+
+// Ensure the parser can parse strings into COPY statements
+func (suite *ParserTestSuite) TestCopyStatement() {
+	var tests = []TestCase{
+		{
+			s:    `COPY users FROM "users.jsonl" FORMAT jsonl`,
+			stmt: &CopyFromStatement{Keyspace: "users", Path: "users.jsonl", Format: JSONLinesFormat},
+		},
+		{
+			s:    `COPY users.convo TO "convos.csv" FORMAT csv;`,
+			stmt: &CopyToStatement{Keyspace: "users.convo", Path: "convos.csv", Format: CSVFormat},
+		},
+		{
+			s:    `COPY users FROM "users.jsonl" FORMAT JSONL`,
+			stmt: &CopyFromStatement{Keyspace: "users", Path: "users.jsonl", Format: JSONLinesFormat},
+		},
+		{
+			s:    `COPY users TO "users.csv" FORMAT "CSV"`,
+			stmt: &CopyToStatement{Keyspace: "users", Path: "users.csv", Format: CSVFormat},
+		},
+
+		// Errors
+		{s: `COPY`, err: `found EOF, expected keyspace at line 1, char 6`},
+		{s: `COPY users`, err: `found EOF, expected FROM, TO at line 1, char 12`},
+		{s: `COPY users INTO "users.jsonl"`, err: `found INTO, expected FROM, TO at line 1, char 12`},
+		{s: `COPY keyspace FROM "users.jsonl" FORMAT csv`, err: `found FROM, expected keyspace at line 1, char 15`},
+		{s: `COPY users FROM`, err: `found EOF, expected string at line 1, char 17`},
+		{s: `COPY users FROM "users.jsonl"`, err: `found EOF, expected FORMAT at line 1, char 30`},
+		{s: `COPY users FROM "users.jsonl" FORMAT`, err: `found EOF, expected jsonl, csv at line 1, char 38`},
+		{s: `COPY users FROM "users.xml" FORMAT xml`, err: `found xml, expected jsonl, csv at line 1, char 36`},
+		{s: `COPY users FROM "users.xml" FORMAT "xml"`, err: `found xml, expected jsonl, csv at line 1, char 35`},
+		{s: `COPY users FROM "users.jsonl" FORMAT 1`, err: `found NUMBER, expected jsonl, csv at line 1, char 38`},
+		{s: `COPY users TO "users.csv" FORMAT csv users`, err: `found IDENTIFIER (users), expected EOF, SEMICOLON at line 1, char 38`},
+	}
+
+	suite.validate(tests)
+}
+
+// Ensure the parser can parse strings into WATCH statements
+func (suite *ParserTestSuite) TestWatchStatement() {
+	var tests = []TestCase{
+		{
+			s: `WATCH FROM users WHERE username = "bugs.bunny"`,
+			stmt: &WatchStatement{Keyspace: "users",
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "username",
+						Value:        StringLiteral{"bugs.bunny"},
+					},
+				},
+			},
+		},
+		{
+			s: `WATCH FROM users WHERE username = "bugs.bunny" OR "daffy.duck" AND timestamp BETWEEN "2015-01-01" AND "2016-01-01"`,
+			stmt: &WatchStatement{Keyspace: "users",
+				Where: []Expression{
+					EqualityExpression{
+						KeyAttribute: "username",
+						Value: StringLiteralGroup{
+							Values:   []string{"bugs.bunny", "daffy.duck"},
+							Operator: OrOperator,
+						},
+					},
+					BetweenExpression{
+						KeyAttribute: "timestamp",
+						Values: StringLiteralGroup{
+							Values:   []string{"2015-01-01", "2016-01-01"},
+							Operator: AndOperator,
+						},
+					},
+				},
+			},
+		},
+
+		// Errors
+		{s: `WATCH`, err: `found EOF, expected FROM at line 1, char 7`},
+		{s: `WATCH FROM users`, err: `found EOF, expected WHERE at line 1, char 18`},
+		{s: `WATCH FROM users WHERE`, err: `found EOF, expected identifier at line 1, char 24`},
+	}
+
+	suite.validate(tests)
+}
+
+// Ensure parse errors can be encoded as structured JSON
+func (suite *ParserTestSuite) TestParseErrorJSON() {
+	var tests = []struct {
+		s    string
+		json string
+	}{
+		{
+			s:    `SELECT FROM users`,
+			json: `{"error":"found EOF, expected AS, WHERE at line 1, char 19","found":"EOF","expected":["AS","WHERE"],"line":1,"char":19}`,
+		},
+		{
+			s:    `UPSERT "..." INTO users WHERE username = "bugs.bunny" OR`,
+			json: `{"error":"OR not allowed at line 1, char 55","message":"OR not allowed","line":1,"char":55}`,
+		},
+	}
+
+	for i, tt := range tests {
+		_, err := ParseString(tt.s)
+		if err == nil {
+			suite.T().Errorf("%d. %q: expected error", i, tt.s)
+			continue
+		}
+
+		b, err := json.Marshal(err)
+		if err != nil {
+			suite.T().Errorf("%d. %q: unexpected marshal error: %s", i, tt.s, err)
+		} else if string(b) != tt.json {
+			suite.T().Errorf("%d. %q: json mismatch:\n  exp=%s\n  got=%s\n\n", i, tt.s, tt.json, b)
+		}
+	}
+}
+
 // errstring converts an error to its string representation.
 func errstring(err error) string {
 	if err != nil {
@@ -362,9 +1248,9 @@ func BenchmarkLargeUpsertStatement(b *testing.B) {
 	}
 }
 
-// func BenchmarkShowNamespacesStatement(b *testing.B) {
-// 	stmt := "SHOW KEYSPACES"
-// 	for i := 0; i < b.N; i++ {
-// 		NewParser(strings.NewReader(stmt)).ParseStatement()
-// 	}
-// }
+func BenchmarkShowNamespacesStatement(b *testing.B) {
+	stmt := "SHOW KEYSPACES"
+	for i := 0; i < b.N; i++ {
+		NewParser(strings.NewReader(stmt)).ParseStatement()
+	}
+}